@@ -0,0 +1,41 @@
+package vector
+
+// an axis aligned bounding box described by its min and max corners
+type AABB struct {
+	Min, Max Vector
+}
+
+// creates an AABB from any two opposite corners
+func NewAABB(a, b Vector) AABB {
+	return AABB{Min(a, b), Max(a, b)}
+}
+
+// returns the width, height and depth of the box as a Vector
+func (b AABB) Size() Vector {
+	return Sub(b.Max, b.Min)
+}
+
+// returns the centre point of the box
+func (b AABB) Center() Vector {
+	return Mult(Add(b.Min, b.Max), 0.5)
+}
+
+// check if the point is inside (or on the edge of) the box
+func (b AABB) Contains(p Vector) bool {
+	return p.X >= b.Min.X && p.X <= b.Max.X &&
+		p.Y >= b.Min.Y && p.Y <= b.Max.Y &&
+		p.Z >= b.Min.Z && p.Z <= b.Max.Z
+}
+
+// moves p inside the rectangle from lo to hi in the xy plane, and reports
+// whether it had to move. Z is left alone
+func ClampToRect(p, lo, hi Vector) (Vector, bool) {
+	c := Vector{max(lo.X, min(p.X, hi.X)), max(lo.Y, min(p.Y, hi.Y)), p.Z}
+	return c, c != p
+}
+
+// moves p inside the box from lo to hi, and reports whether it had to move
+func ClampToBox(p, lo, hi Vector) (Vector, bool) {
+	c := Clamp(p, lo, hi)
+	return c, c != p
+}
@@ -0,0 +1,25 @@
+package vector
+
+import "testing"
+
+func TestClampToRect(t *testing.T) {
+	lo, hi := NewVector(0, 0), NewVector(100, 50)
+
+	if p, moved := ClampToRect(NewVector(120, -3, 7), lo, hi); !moved || !p.Equals(NewVector(100, 0, 7)) {
+		t.Errorf("should move to (100, 0, 7) not %v (%v)", p, moved)
+	}
+	if p, moved := ClampToRect(NewVector(20, 30, 7), lo, hi); moved || !p.Equals(NewVector(20, 30, 7)) {
+		t.Errorf("shouldn't move a point inside, got %v (%v)", p, moved)
+	}
+}
+
+func TestClampToBox(t *testing.T) {
+	lo, hi := NewVector(-1, -1, -1), NewVector(1, 1, 1)
+
+	if p, moved := ClampToBox(NewVector(0.5, 0, 3), lo, hi); !moved || !p.Equals(NewVector(0.5, 0, 1)) {
+		t.Errorf("should move to (0.5, 0, 1) not %v (%v)", p, moved)
+	}
+	if _, moved := ClampToBox(NewVector(1, -1, 0), lo, hi); moved {
+		t.Error("a point on the edge shouldn't move")
+	}
+}
@@ -0,0 +1,101 @@
+package vector
+
+import "math"
+
+// a 2d affine transform, the top two rows of a Mat3 in row major order
+//
+//	x' = a[0]*x + a[1]*y + a[2]
+//	y' = a[3]*x + a[4]*y + a[5]
+//
+// As with Mat3, a.Mul(b) applies b first, then a. The Translate, Rotate, Scale
+// and Shear methods work like a canvas: each one applies in the local space
+// set up by the ones before it
+type Affine2D [6]float64
+
+// the transform that leaves points where they are
+func IdentityAffine2D() Affine2D {
+	return Affine2D{1, 0, 0, 0, 1, 0}
+}
+
+// moves points by tx, ty
+func TranslateAffine2D(tx, ty float64) Affine2D {
+	return Affine2D{1, 0, tx, 0, 1, ty}
+}
+
+// rotates points about the origin by angle, the same as Rotate
+func RotateAffine2D(angle float64) Affine2D {
+	c := math.Cos(-angle)
+	s := math.Sin(-angle)
+	return Affine2D{c, -s, 0, s, c, 0}
+}
+
+// scales points about the origin
+func ScaleAffine2D(sx, sy float64) Affine2D {
+	return Affine2D{sx, 0, 0, 0, sy, 0}
+}
+
+// shears points, moving x by kx*y and y by ky*x
+func ShearAffine2D(kx, ky float64) Affine2D {
+	return Affine2D{1, kx, 0, ky, 1, 0}
+}
+
+// the composition a * b, b applied first
+func (a Affine2D) Mul(b Affine2D) Affine2D {
+	return Affine2D{
+		a[0]*b[0] + a[1]*b[3], a[0]*b[1] + a[1]*b[4], a[0]*b[2] + a[1]*b[5] + a[2],
+		a[3]*b[0] + a[4]*b[3], a[3]*b[1] + a[4]*b[4], a[3]*b[2] + a[4]*b[5] + a[5],
+	}
+}
+
+// a with a translation in its local space
+func (a Affine2D) Translate(tx, ty float64) Affine2D {
+	return a.Mul(TranslateAffine2D(tx, ty))
+}
+
+// a with a rotation in its local space
+func (a Affine2D) Rotate(angle float64) Affine2D {
+	return a.Mul(RotateAffine2D(angle))
+}
+
+// a with a scale in its local space
+func (a Affine2D) Scale(sx, sy float64) Affine2D {
+	return a.Mul(ScaleAffine2D(sx, sy))
+}
+
+// a with a shear in its local space
+func (a Affine2D) Shear(kx, ky float64) Affine2D {
+	return a.Mul(ShearAffine2D(kx, ky))
+}
+
+// transforms the point v. Z is passed through unchanged
+func (a Affine2D) Apply(v Vector) Vector {
+	return Vector{a[0]*v.X + a[1]*v.Y + a[2], a[3]*v.X + a[4]*v.Y + a[5], v.Z}
+}
+
+// transforms the direction v, ignoring the translation
+func (a Affine2D) ApplyDir(v Vector) Vector {
+	return Vector{a[0]*v.X + a[1]*v.Y, a[3]*v.X + a[4]*v.Y, v.Z}
+}
+
+// the inverse transform, or false if it has none
+func (a Affine2D) Inverse() (Affine2D, bool) {
+	d := a[0]*a[4] - a[1]*a[3]
+	if d == 0 {
+		return Affine2D{}, false
+	}
+	i0, i1 := a[4]/d, -a[1]/d
+	i3, i4 := -a[3]/d, a[0]/d
+	return Affine2D{
+		i0, i1, -(i0*a[2] + i1*a[5]),
+		i3, i4, -(i3*a[2] + i4*a[5]),
+	}, true
+}
+
+// the transform as a full Mat3
+func (a Affine2D) Mat3() Mat3 {
+	return Mat3{
+		a[0], a[1], a[2],
+		a[3], a[4], a[5],
+		0, 0, 1,
+	}
+}
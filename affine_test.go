@@ -0,0 +1,51 @@
+package vector
+
+import "testing"
+
+func TestAffine2D(t *testing.T) {
+	t.Run("matches Rotate and Add", func(t *testing.T) {
+		v := NewVector(3, 1)
+		a := TranslateAffine2D(5, -2).Mul(RotateAffine2D(0.7))
+
+		expected := Add(Rotate(v, 0.7), NewVector(5, -2))
+		if r := a.Apply(v); !r.Equals(expected) {
+			t.Errorf("should be %v not %v", expected, r)
+		}
+		if r := a.ApplyDir(v); !r.Equals(Rotate(v, 0.7)) {
+			t.Errorf("direction should ignore translation, got %v", r)
+		}
+	})
+
+	t.Run("methods compose in local space", func(t *testing.T) {
+		a := IdentityAffine2D().Translate(10, 0).Scale(2, 2)
+
+		if r := a.Apply(NewVector(1, 1)); !r.Equals(NewVector(12, 2)) {
+			t.Errorf("should be (12, 2) not %v", r)
+		}
+	})
+
+	t.Run("shear", func(t *testing.T) {
+		if r := ShearAffine2D(0.5, 0).Apply(NewVector(1, 2)); !r.Equals(NewVector(2, 2)) {
+			t.Errorf("should be (2, 2) not %v", r)
+		}
+	})
+
+	t.Run("inverse and Mat3 agree", func(t *testing.T) {
+		a := IdentityAffine2D().Translate(3, 4).Rotate(1.1).Scale(2, 0.5).Shear(0.3, 0)
+		v := NewVector(-2, 7)
+
+		inv, ok := a.Inverse()
+		if !ok {
+			t.Fatal("should be invertible")
+		}
+		if back := inv.Apply(a.Apply(v)); !back.Equals(v) {
+			t.Errorf("should come back to %v not %v", v, back)
+		}
+		if r := a.Mat3().Transform(v); !r.Equals(a.Apply(v)) {
+			t.Errorf("Mat3 should give %v not %v", a.Apply(v), r)
+		}
+		if _, ok := ScaleAffine2D(0, 1).Inverse(); ok {
+			t.Error("a flattening scale should have no inverse")
+		}
+	})
+}
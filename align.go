@@ -0,0 +1,46 @@
+package vector
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrPointSetSize       = errors.New("vector: point sets must be the same size with at least 2 points")
+	ErrDegeneratePointSet = errors.New("vector: source points are all in the same place")
+)
+
+// finds the similarity transform (rotation, uniform scale, translation) that
+// best maps src onto dst in the least squares sense (2d Procrustes/Umeyama)
+//
+// src[i] is paired with dst[i] and Z is ignored. The transform is applied as
+//
+//	Add(Mult(Rotate(p, rotation), scale), translation)
+func AlignPointSets(src, dst []Vector) (rotation float64, translation Vector, scale float64, err error) {
+	if len(src) != len(dst) || len(src) < 2 {
+		return 0, Vector{}, 0, ErrPointSetSize
+	}
+
+	cs := centroid(src)
+	cd := centroid(dst)
+
+	var dot, cross, norm float64
+	for i := range src {
+		p := Sub(src[i], cs)
+		q := Sub(dst[i], cd)
+		dot += p.X*q.X + p.Y*q.Y
+		cross += p.X*q.Y - p.Y*q.X
+		norm += p.X*p.X + p.Y*p.Y
+	}
+
+	if norm == 0 {
+		return 0, Vector{}, 0, ErrDegeneratePointSet
+	}
+
+	// atan2 gives the anticlockwise (y-up) angle, Rotate turns the other way
+	rotation = -math.Atan2(cross, dot)
+	scale = math.Hypot(dot, cross) / norm
+	translation = Sub(NewVector(cd.X, cd.Y), Mult(Rotate(cs, rotation), scale))
+
+	return rotation, translation, scale, nil
+}
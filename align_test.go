@@ -0,0 +1,48 @@
+package vector
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAlignPointSets(t *testing.T) {
+	t.Run("recovers a known transform", func(t *testing.T) {
+		src := []Vector{NewVector(0, 0), NewVector(2, 0), NewVector(2, 1), NewVector(-1, 3)}
+		rotation, scale, translation := 0.6, 2.5, NewVector(10, -4)
+
+		dst := make([]Vector, len(src))
+		for i, p := range src {
+			dst[i] = Add(Mult(Rotate(p, rotation), scale), translation)
+		}
+
+		r, tr, s, err := AlignPointSets(src, dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !compare(t, r, rotation) || !compare(t, s, scale) || !tr.Equals(translation) {
+			t.Errorf("should be %f %f %v not %f %f %v", rotation, scale, translation, r, s, tr)
+		}
+	})
+
+	t.Run("half turn", func(t *testing.T) {
+		src := []Vector{NewVector(1, 0), NewVector(-1, 0)}
+		dst := []Vector{NewVector(-1, 0), NewVector(1, 0)}
+
+		r, _, s, err := AlignPointSets(src, dst)
+		if err != nil || !compare(t, math.Abs(r), math.Pi) || !compare(t, s, 1) {
+			t.Errorf("should be a half turn %f %f %v", r, s, err)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, _, _, err := AlignPointSets([]Vector{NewVector()}, []Vector{NewVector()}); !errors.Is(err, ErrPointSetSize) {
+			t.Errorf("should be ErrPointSetSize not %v", err)
+		}
+
+		same := []Vector{NewVector(1, 1), NewVector(1, 1)}
+		if _, _, _, err := AlignPointSets(same, square(0, 0, 1)[:2]); !errors.Is(err, ErrDegeneratePointSet) {
+			t.Errorf("should be ErrDegeneratePointSet not %v", err)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package vector
+
+// a point on a rectangle as fractions of its width and height, measured from
+// the top left on a y-down screen: (0, 0) is the top left, (1, 1) the bottom right
+type Anchor struct {
+	X, Y float64
+}
+
+var (
+	AnchorTopLeft     = Anchor{0, 0}
+	AnchorTop         = Anchor{0.5, 0}
+	AnchorTopRight    = Anchor{1, 0}
+	AnchorLeft        = Anchor{0, 0.5}
+	AnchorCenter      = Anchor{0.5, 0.5}
+	AnchorRight       = Anchor{1, 0.5}
+	AnchorBottomLeft  = Anchor{0, 1}
+	AnchorBottom      = Anchor{0.5, 1}
+	AnchorBottomRight = Anchor{1, 1}
+)
+
+// a custom anchor fx of the way across and fy of the way down
+func NewAnchor(fx, fy float64) Anchor {
+	return Anchor{fx, fy}
+}
+
+// how far the anchor is from the top left of a rectangle of the given size
+func AnchorOffset(size Vector, anchor Anchor) Vector {
+	return NewVector(size.X*anchor.X, size.Y*anchor.Y)
+}
+
+// the top left corner to draw a rectangle of the given size at so its anchor is at pos
+func PositionWithAnchor(pos, size Vector, anchor Anchor) Vector {
+	return Sub(pos, AnchorOffset(size, anchor))
+}
@@ -0,0 +1,25 @@
+package vector
+
+import "testing"
+
+func TestAnchor(t *testing.T) {
+	size := NewVector(40, 20)
+
+	for name, tc := range map[string]struct {
+		anchor   Anchor
+		offset   Vector
+		position Vector
+	}{
+		"top left":     {AnchorTopLeft, NewVector(0, 0), NewVector(100, 50)},
+		"center":       {AnchorCenter, NewVector(20, 10), NewVector(80, 40)},
+		"bottom right": {AnchorBottomRight, NewVector(40, 20), NewVector(60, 30)},
+		"custom":       {NewAnchor(0.25, 1), NewVector(10, 20), NewVector(90, 30)},
+	} {
+		if o := AnchorOffset(size, tc.anchor); !o.Equals(tc.offset) {
+			t.Errorf("%s offset should be %v not %v", name, tc.offset, o)
+		}
+		if p := PositionWithAnchor(NewVector(100, 50), size, tc.anchor); !p.Equals(tc.position) {
+			t.Errorf("%s position should be %v not %v", name, tc.position, p)
+		}
+	}
+}
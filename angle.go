@@ -0,0 +1,86 @@
+package vector
+
+import "math"
+
+// the angle a wrapped into (-π, π], the same range Heading and Direction return
+func NormalizeAngle(a float64) float64 {
+	a = math.Mod(a+math.Pi, 2*math.Pi)
+	if a <= 0 {
+		a += 2 * math.Pi
+	}
+	return a - math.Pi
+}
+
+// the shortest signed turn from the angle from to the angle to, in (-π, π].
+// Positive turns go the same way as Rotate
+func DeltaAngle(from, to float64) float64 {
+	return NormalizeAngle(to - from)
+}
+
+// interpolates from the angle a (t = 0) to b (t = 1) the short way round. The result is in (-π, π]
+func LerpAngle(a, b, t float64) float64 {
+	return NormalizeAngle(a + DeltaAngle(a, b)*t)
+}
+
+// an angle in radians, for when it matters which unit a value is in
+//
+// Build one with Radians or Degrees and read it back the same way, eg
+// Degrees(90).Radians(). Angles go the same way as FromAngle and Rotate
+type Angle float64
+
+// the angle of r radians
+func Radians(r float64) Angle {
+	return Angle(r)
+}
+
+// the angle of d degrees
+func Degrees(d float64) Angle {
+	return Angle(d * math.Pi / 180)
+}
+
+// the angle in radians
+func (a Angle) Radians() float64 {
+	return float64(a)
+}
+
+// the angle in degrees
+func (a Angle) Degrees() float64 {
+	return float64(a) * 180 / math.Pi
+}
+
+// the angle wrapped into (-π, π], see NormalizeAngle
+func (a Angle) Normalize() Angle {
+	return Angle(NormalizeAngle(float64(a)))
+}
+
+// the vector of the given length pointing at the angle, the same as FromAngle
+func (a Angle) Vector(length float64) Vector {
+	return FromAngle(float64(a), length)
+}
+
+// v rotated by the angle, the same as Rotate
+func (a Angle) Rotate(v Vector) Vector {
+	return Rotate(v, float64(a))
+}
+
+// the direction v points in as an Angle, going the same way as FromAngle and
+// Rotate so Direction(v).Vector(1) points along v. It's Heading with the
+// sign flipped to match
+func Direction(v Vector) Angle {
+	return Angle(heading(v))
+}
+
+// the direction this vector points in as an Angle, see Direction
+func (v Vector) Direction() Angle {
+	return Direction(v)
+}
+
+// the unsigned angle separating v1 and v2, the typed form of AngleBetween
+func Separation(v1, v2 Vector) Angle {
+	return Angle(AngleBetween(v1, v2))
+}
+
+// the unsigned angle separating this vector and other, see Separation
+func (v Vector) Separation(other Vector) Angle {
+	return Separation(v, other)
+}
@@ -0,0 +1,69 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormalizeAngle(t *testing.T) {
+	for _, tc := range []struct{ a, expected float64 }{
+		{0, 0},
+		{math.Pi, math.Pi},
+		{-math.Pi, math.Pi},
+		{3 * math.Pi / 2, -math.Pi / 2},
+		{-5 * math.Pi / 2, -math.Pi / 2},
+		{7, 7 - 2*math.Pi},
+	} {
+		if n := NormalizeAngle(tc.a); math.Abs(n-tc.expected) > 1e-12 {
+			t.Errorf("%f should normalise to %f not %f", tc.a, tc.expected, n)
+		}
+	}
+}
+
+func TestDeltaAngle(t *testing.T) {
+	if d := DeltaAngle(3, -3); math.Abs(d-(2*math.Pi-6)) > 1e-12 {
+		t.Errorf("should go the short way through π, got %f", d)
+	}
+	if d := DeltaAngle(0.5, -0.25); math.Abs(d+0.75) > 1e-12 {
+		t.Errorf("should be -0.75 not %f", d)
+	}
+
+	a, b := FromAngle(2.8), FromAngle(-2.9)
+	if r := Rotate(a, DeltaAngle(Direction(a).Radians(), Direction(b).Radians())); !r.Equals(b) {
+		t.Errorf("rotating by the delta should line up, got %v for %v", r, b)
+	}
+}
+
+func TestLerpAngle(t *testing.T) {
+	if l := LerpAngle(3, -3, 0.5); math.Abs(l-math.Pi) > 1e-12 {
+		t.Errorf("half way from 3 to -3 should be π not %f", l)
+	}
+	if l := LerpAngle(0, 1, 0.25); math.Abs(l-0.25) > 1e-12 {
+		t.Errorf("should be 0.25 not %f", l)
+	}
+}
+
+func TestAngle(t *testing.T) {
+	if r := Degrees(90).Radians(); math.Abs(r-math.Pi/2) > 1e-12 {
+		t.Errorf("90 degrees should be π/2 not %f", r)
+	}
+	if d := Radians(math.Pi).Degrees(); math.Abs(d-180) > 1e-12 {
+		t.Errorf("π should be 180 degrees not %f", d)
+	}
+	if d := Degrees(270).Normalize().Degrees(); math.Abs(d+90) > 1e-9 {
+		t.Errorf("270 degrees should normalise to -90 not %f", d)
+	}
+
+	if v := Degrees(90).Vector(2); !v.Equals(FromAngle(math.Pi/2, 2)) {
+		t.Errorf("should match FromAngle, got %v", v)
+	}
+	if v := Degrees(45).Rotate(NewVector(1, 0)); !v.Equals(Rotate(NewVector(1, 0), math.Pi/4)) {
+		t.Errorf("should match Rotate, got %v", v)
+	}
+	if h := Direction(NewVector(0, -3)).Degrees(); math.Abs(h-90) > 1e-9 {
+		t.Errorf("heading of (0, -3) should be 90 degrees not %f", h)
+	}
+	if a := NewVector(1, 0).Separation(NewVector(1, 1)).Degrees(); math.Abs(a-45) > 1e-9 {
+		t.Errorf("should be 45 degrees not %f", a)
+	}
+}
@@ -0,0 +1,49 @@
+package vector
+
+// a steering correction that pushes an agent sideways out of the way of obstacles
+//
+// The agent looks lookAhead along its velocity and the closest obstacle that
+// line passes through is avoided. The correction grows the closer the line
+// gets to the obstacle's centre, up to the agent's speed. Returns a zero Vector
+// if nothing is in the way
+func AvoidObstacles(pos, vel Vector, obstacles []Circle, lookAhead float64) Vector {
+	speed := vel.Mag()
+	if speed == 0 {
+		return Vector{}
+	}
+
+	dir := Div(vel, speed)
+	ahead := Add(pos, Mult(dir, lookAhead))
+
+	var threat *Circle
+	var closest Vector
+	nearest := 0.0
+
+	for i := range obstacles {
+		o := &obstacles[i]
+		c, _ := closestOnSegment(pos, ahead, o.Center)
+		if !o.Contains(c) {
+			continue
+		}
+
+		if d := Dist(pos, o.Center); threat == nil || d < nearest {
+			threat = o
+			closest = c
+			nearest = d
+		}
+	}
+
+	if threat == nil {
+		return Vector{}
+	}
+
+	away := Sub(closest, threat.Center)
+	d := away.Mag()
+	if d == 0 {
+		// heading straight for the centre so pick a side
+		away = NewVector(-dir.Y, dir.X)
+	}
+
+	away.SetMag(speed * (1 - d/threat.R))
+	return away
+}
@@ -0,0 +1,37 @@
+package vector
+
+import "testing"
+
+func TestAvoidObstacles(t *testing.T) {
+	obstacles := []Circle{
+		{NewVector(10, 1), 2},
+		{NewVector(50, 0), 5},
+	}
+
+	t.Run("steers away from the obstacle ahead", func(t *testing.T) {
+		s := AvoidObstacles(NewVector(0, 0), NewVector(2, 0), obstacles, 15)
+
+		if s.Y >= 0 || s.X != 0 {
+			t.Errorf("should steer up (-y) away from the obstacle not %v", s)
+		}
+		if !compare(t, s.Mag(), 1) {
+			t.Errorf("should be half the speed not %f", s.Mag())
+		}
+	})
+
+	t.Run("ignores obstacles out of range", func(t *testing.T) {
+		s := AvoidObstacles(NewVector(0, 0), NewVector(2, 0), obstacles, 5)
+
+		if !s.Equals(Vector{}) {
+			t.Errorf("nothing in range so should be zero not %v", s)
+		}
+	})
+
+	t.Run("head on picks a side", func(t *testing.T) {
+		s := AvoidObstacles(NewVector(30, 0), NewVector(1, 0), obstacles, 30)
+
+		if s.MagSq() == 0 || s.X != 0 {
+			t.Errorf("should steer sideways not %v", s)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package vector
+
+// a soft body: a ring of Verlet points held together by sticks round the
+// perimeter and a pressure constraint that keeps the area near its rest value
+//
+// Pressure scales the area the blob tries to keep, so values above 1 inflate
+// it. Gravity, Iterations and Damping work as they do for Rope
+type Blob struct {
+	Points     []VerletPoint
+	Sticks     []Stick
+	Gravity    Vector
+	Iterations int
+	Damping    float64
+	Pressure   float64
+
+	restArea float64
+}
+
+// a circular blob of radius r made of n points
+func NewBlob(center Vector, r float64, n int) *Blob {
+	n = max(n, 3)
+	b := &Blob{
+		Points:     make([]VerletPoint, n),
+		Sticks:     make([]Stick, n),
+		Gravity:    NewVector(0, 9.81),
+		Iterations: 10,
+		Damping:    0.99,
+		Pressure:   1,
+	}
+	for i, p := range CirclePoints(center, r, n) {
+		b.Points[i] = NewVerletPoint(p)
+	}
+	for i := range b.Sticks {
+		b.Sticks[i] = NewStick(b.Points, i, (i+1)%n)
+	}
+	b.restArea = b.Outline().Area()
+	return b
+}
+
+// advances the blob by dt
+func (b *Blob) Update(dt float64) {
+	for i := range b.Points {
+		b.Points[i].Step(b.Gravity, dt, b.Damping)
+	}
+	for range b.Iterations {
+		SatisfySticks(b.Points, b.Sticks, 1)
+		b.inflate()
+	}
+}
+
+// moves every point along its outward normal so the area heads back to the target
+func (b *Blob) inflate() {
+	outline := b.Outline()
+	area := outline.signedArea()
+	sign := 1.0
+	if area < 0 {
+		sign = -1
+	}
+
+	perimeter := 0.0
+	for _, s := range b.Sticks {
+		perimeter += s.Length
+	}
+	if perimeter == 0 {
+		return
+	}
+	dilation := (b.restArea*b.Pressure - sign*area) / perimeter
+
+	n := len(outline)
+	for i := range b.Points {
+		if b.Points[i].Pinned {
+			continue
+		}
+		edge := Sub(outline[(i+1)%n], outline[(i+n-1)%n])
+		if edge.MagSq() == 0 {
+			continue
+		}
+		normal := Normalise(NewVector(edge.Y*sign, -edge.X*sign))
+		b.Points[i].Pos.Add(Mult(normal, dilation))
+	}
+}
+
+// the current outline, for drawing
+func (b *Blob) Outline() Polygon {
+	p := make(Polygon, len(b.Points))
+	for i, pt := range b.Points {
+		p[i] = pt.Pos
+	}
+	return p
+}
+
+// check if the point is inside the blob
+func (b *Blob) Contains(p Vector) bool {
+	return b.Outline().Contains(p)
+}
@@ -0,0 +1,52 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBlob(t *testing.T) {
+	t.Run("keeps its area while falling", func(t *testing.T) {
+		b := NewBlob(NewVector(0, 0), 10, 24)
+		rest := b.Outline().Area()
+
+		for range 120 {
+			b.Update(1.0 / 60)
+		}
+
+		if a := b.Outline().Area(); math.Abs(a-rest)/rest > 0.05 {
+			t.Errorf("area should stay near %f not %f", rest, a)
+		}
+		if c := centroid(b.Outline()); c.Y <= 0 {
+			t.Errorf("should have fallen, centre is %v", c)
+		}
+	})
+
+	t.Run("recovers from squashing", func(t *testing.T) {
+		b := NewBlob(NewVector(0, 0), 10, 24)
+		b.Gravity = Vector{}
+		rest := b.Outline().Area()
+		for i := range b.Points {
+			b.Points[i].Place(NewVector(b.Points[i].Pos.X, b.Points[i].Pos.Y*0.3))
+		}
+
+		for range 300 {
+			b.Update(1.0 / 60)
+		}
+
+		if a := b.Outline().Area(); math.Abs(a-rest)/rest > 0.05 {
+			t.Errorf("area should recover to %f not %f", rest, a)
+		}
+	})
+
+	t.Run("contains", func(t *testing.T) {
+		b := NewBlob(NewVector(5, 5), 10, 16)
+
+		if !b.Contains(NewVector(5, 5)) {
+			t.Error("should contain its centre")
+		}
+		if b.Contains(NewVector(20, 5)) {
+			t.Error("shouldn't contain a point outside")
+		}
+	})
+}
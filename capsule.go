@@ -0,0 +1,84 @@
+package vector
+
+// a capsule: every point within R of the segment A-B
+type Capsule struct {
+	A, B Vector
+	R    float64
+}
+
+// check if the point is inside (or on the edge of) the capsule
+func (c Capsule) Contains(p Vector) bool {
+	q, _ := closestOnSegment(c.A, c.B, p)
+	return MagSq(Sub(p, q)) <= c.R*c.R
+}
+
+// the closest point in the capsule to p. Points inside the capsule are returned as is
+func (c Capsule) ClosestPoint(p Vector) Vector {
+	q, _ := closestOnSegment(c.A, c.B, p)
+	d := Sub(p, q)
+	if d.MagSq() <= c.R*c.R {
+		return p
+	}
+
+	d.SetMag(c.R)
+	return Add(q, d)
+}
+
+// check if the capsule and circle overlap
+func (c Capsule) IntersectsCircle(circle Circle) bool {
+	q, _ := closestOnSegment(c.A, c.B, circle.Center)
+	r := c.R + circle.R
+	return MagSq(Sub(circle.Center, q)) <= r*r
+}
+
+// check if the two capsules overlap
+func (c Capsule) IntersectsCapsule(other Capsule) bool {
+	p, q := closestBetweenSegments(c.A, c.B, other.A, other.B)
+	r := c.R + other.R
+	return MagSq(Sub(p, q)) <= r*r
+}
+
+// the closest points between segments p1-q1 and p2-q2 (from Real-Time Collision Detection 5.1.9)
+func closestBetweenSegments(p1, q1, p2, q2 Vector) (Vector, Vector) {
+	d1 := Sub(q1, p1)
+	d2 := Sub(q2, p2)
+	r := Sub(p1, p2)
+
+	a := d1.MagSq()
+	e := d2.MagSq()
+	f := d2.DotProduct(r)
+
+	var s, t float64
+	switch {
+	case a == 0 && e == 0:
+		return p1, p2
+	case a == 0:
+		t = clamp01(f / e)
+	default:
+		c := d1.DotProduct(r)
+		if e == 0 {
+			s = clamp01(-c / a)
+		} else {
+			b := d1.DotProduct(d2)
+			denom := a*e - b*b
+			if denom != 0 {
+				s = clamp01((b*f - c*e) / denom)
+			}
+
+			t = (b*s + f) / e
+			if t < 0 {
+				t = 0
+				s = clamp01(-c / a)
+			} else if t > 1 {
+				t = 1
+				s = clamp01((b - c) / a)
+			}
+		}
+	}
+
+	return Add(p1, Mult(d1, s)), Add(p2, Mult(d2, t))
+}
+
+func clamp01(x float64) float64 {
+	return max(0, min(1, x))
+}
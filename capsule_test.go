@@ -0,0 +1,44 @@
+package vector
+
+import "testing"
+
+func TestCapsule(t *testing.T) {
+	c := Capsule{NewVector(0, 0), NewVector(10, 0), 1}
+
+	t.Run("contains", func(t *testing.T) {
+		if !c.Contains(NewVector(5, 0.9)) || !c.Contains(NewVector(10.5, 0.5)) {
+			t.Error("should contain points in the body and the cap")
+		}
+		if c.Contains(NewVector(5, 1.1)) || c.Contains(NewVector(-1, 1)) {
+			t.Error("should not contain points outside")
+		}
+	})
+
+	t.Run("closest point", func(t *testing.T) {
+		if p := c.ClosestPoint(NewVector(5, 4)); !p.Equals(NewVector(5, 1)) {
+			t.Errorf("should be {5, 1} not %v", p)
+		}
+		if p := c.ClosestPoint(NewVector(13, 0)); !p.Equals(NewVector(11, 0)) {
+			t.Errorf("should be {11, 0} not %v", p)
+		}
+	})
+
+	t.Run("overlaps", func(t *testing.T) {
+		if !c.IntersectsCircle(Circle{NewVector(5, 2.5), 1.5}) {
+			t.Error("should touch the circle")
+		}
+		if c.IntersectsCircle(Circle{NewVector(-3, 0), 1.5}) {
+			t.Error("should miss the circle")
+		}
+
+		crossing := Capsule{NewVector(5, -5), NewVector(5, 5), 0.5}
+		if !c.IntersectsCapsule(crossing) {
+			t.Error("crossing capsules should overlap")
+		}
+
+		parallel := Capsule{NewVector(0, 3), NewVector(10, 3), 0.9}
+		if c.IntersectsCapsule(parallel) {
+			t.Error("parallel capsules should not overlap")
+		}
+	})
+}
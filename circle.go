@@ -0,0 +1,18 @@
+package vector
+
+// a circle (or sphere in 3d) of radius R around Center
+type Circle struct {
+	Center Vector
+	R      float64
+}
+
+// check if the point is inside (or on the edge of) the circle
+func (c Circle) Contains(p Vector) bool {
+	return MagSq(Sub(p, c.Center)) <= c.R*c.R
+}
+
+// check if the two circles overlap
+func (c Circle) Intersects(other Circle) bool {
+	r := c.R + other.R
+	return MagSq(Sub(c.Center, other.Center)) <= r*r
+}
@@ -0,0 +1,94 @@
+package vector
+
+import (
+	"math"
+	"slices"
+)
+
+// finds the two closest points in the xy plane in O(n log n) (divide and conquer)
+//
+// Returns their indices (i < j) and the distance between them. With fewer than
+// two points it returns -1, -1 and +Inf
+func ClosestPair(points []Vector) (i, j int, dist float64) {
+	if len(points) < 2 {
+		return -1, -1, math.Inf(1)
+	}
+
+	byX := make([]int, len(points))
+	for k := range byX {
+		byX[k] = k
+	}
+	slices.SortFunc(byX, func(a, b int) int { return cmpFloat(points[a].X, points[b].X) })
+
+	c := closestPairs{points: points, bestI: -1, bestJ: -1, best: math.Inf(1)}
+	c.solve(byX)
+
+	i, j = c.bestI, c.bestJ
+	if i > j {
+		i, j = j, i
+	}
+	return i, j, c.best
+}
+
+type closestPairs struct {
+	points       []Vector
+	bestI, bestJ int
+	best         float64
+}
+
+func (c *closestPairs) check(a, b int) {
+	pa, pb := c.points[a], c.points[b]
+	if d := math.Hypot(pa.X-pb.X, pa.Y-pb.Y); d < c.best {
+		c.best = d
+		c.bestI, c.bestJ = a, b
+	}
+}
+
+// idx is sorted by x. On return it's sorted by y so the parent can merge the halves
+func (c *closestPairs) solve(idx []int) {
+	byY := func(a, b int) int { return cmpFloat(c.points[a].Y, c.points[b].Y) }
+
+	if len(idx) <= 3 {
+		for a := 0; a < len(idx); a++ {
+			for b := a + 1; b < len(idx); b++ {
+				c.check(idx[a], idx[b])
+			}
+		}
+		slices.SortFunc(idx, byY)
+		return
+	}
+
+	mid := len(idx) / 2
+	midX := c.points[idx[mid]].X
+
+	left := slices.Clone(idx[:mid])
+	right := slices.Clone(idx[mid:])
+	c.solve(left)
+	c.solve(right)
+
+	// merge the halves back together by y
+	l, r := 0, 0
+	for k := range idx {
+		if r >= len(right) || (l < len(left) && byY(left[l], right[r]) <= 0) {
+			idx[k] = left[l]
+			l++
+		} else {
+			idx[k] = right[r]
+			r++
+		}
+	}
+
+	// check the strip either side of the split line
+	strip := []int{}
+	for _, k := range idx {
+		if math.Abs(c.points[k].X-midX) < c.best {
+			strip = append(strip, k)
+		}
+	}
+
+	for a := range strip {
+		for b := a + 1; b < len(strip) && c.points[strip[b]].Y-c.points[strip[a]].Y < c.best; b++ {
+			c.check(strip[a], strip[b])
+		}
+	}
+}
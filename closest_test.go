@@ -0,0 +1,50 @@
+package vector
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestClosestPair(t *testing.T) {
+	t.Run("small set", func(t *testing.T) {
+		points := []Vector{
+			NewVector(0, 0),
+			NewVector(10, 10),
+			NewVector(3, 4),
+			NewVector(10.5, 10),
+			NewVector(-7, 2),
+		}
+
+		i, j, d := ClosestPair(points)
+		if i != 1 || j != 3 || !compare(t, d, 0.5) {
+			t.Errorf("should be 1, 3 at 0.5 not %d, %d at %f", i, j, d)
+		}
+	})
+
+	t.Run("matches brute force", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		points := make([]Vector, 500)
+		for k := range points {
+			points[k] = NewVector(r.Float64()*1000, r.Float64()*1000)
+		}
+
+		best := math.Inf(1)
+		for a := range points {
+			for b := a + 1; b < len(points); b++ {
+				best = min(best, Dist(points[a], points[b]))
+			}
+		}
+
+		i, j, d := ClosestPair(points)
+		if !compare(t, d, best) || !compare(t, Dist(points[i], points[j]), best) {
+			t.Errorf("found %f (expected %f)", d, best)
+		}
+	})
+
+	t.Run("too few points", func(t *testing.T) {
+		if i, j, _ := ClosestPair([]Vector{NewVector()}); i != -1 || j != -1 {
+			t.Error("should find no pair")
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package vector
+
+// a sheet of cloth: a grid of Verlet points joined by structural sticks to
+// their neighbours and shear sticks across each square
+//
+// Wind, if set, is called for every point each update and its result added to
+// the acceleration, eg with Wind or Turbulence. Sticks stretched past
+// TearRatio times their length break; 0 means the cloth never tears.
+// Gravity, Iterations and Damping work as they do for Rope
+type Cloth struct {
+	Cols, Rows int
+	Points     []VerletPoint
+	Sticks     []Stick
+	Gravity    Vector
+	Iterations int
+	Damping    float64
+	TearRatio  float64
+	Wind       func(p Vector) Vector
+}
+
+// a cloth of cols by rows points spacing apart, hanging down from topLeft, with nothing pinned
+func NewCloth(topLeft Vector, cols, rows int, spacing float64) *Cloth {
+	cols, rows = max(cols, 1), max(rows, 1)
+	c := &Cloth{
+		Cols:       cols,
+		Rows:       rows,
+		Points:     make([]VerletPoint, cols*rows),
+		Gravity:    NewVector(0, 9.81),
+		Iterations: 10,
+		Damping:    0.99,
+	}
+
+	for j := range rows {
+		for i := range cols {
+			c.Points[j*cols+i] = NewVerletPoint(Add(topLeft, NewVector(float64(i)*spacing, float64(j)*spacing)))
+		}
+	}
+	for j := range rows {
+		for i := range cols {
+			k := j*cols + i
+			if i+1 < cols {
+				c.Sticks = append(c.Sticks, NewStick(c.Points, k, k+1))
+			}
+			if j+1 < rows {
+				c.Sticks = append(c.Sticks, NewStick(c.Points, k, k+cols))
+			}
+			if i+1 < cols && j+1 < rows {
+				c.Sticks = append(c.Sticks, NewStick(c.Points, k, k+cols+1), NewStick(c.Points, k+1, k+cols))
+			}
+		}
+	}
+	return c
+}
+
+// the index into Points of point (i, j), i being the column
+func (c *Cloth) index(i, j int) int {
+	return j*c.Cols + i
+}
+
+// the position of point (i, j)
+func (c *Cloth) At(i, j int) Vector {
+	return c.Points[c.index(i, j)].Pos
+}
+
+// holds point (i, j) where it is
+func (c *Cloth) Pin(i, j int) {
+	c.Points[c.index(i, j)].Pinned = true
+}
+
+// lets point (i, j) move again
+func (c *Cloth) Unpin(i, j int) {
+	c.Points[c.index(i, j)].Pinned = false
+}
+
+// advances the cloth by dt
+func (c *Cloth) Update(dt float64) {
+	for i := range c.Points {
+		acc := c.Gravity
+		if c.Wind != nil {
+			acc = Add(acc, c.Wind(c.Points[i].Pos))
+		}
+		c.Points[i].Step(acc, dt, c.Damping)
+	}
+
+	// tear before relaxing so a hard yank breaks the sticks it stretches
+	if c.TearRatio > 0 {
+		c.tear()
+	}
+	SatisfySticks(c.Points, c.Sticks, c.Iterations)
+}
+
+// removes the sticks that have been stretched too far
+func (c *Cloth) tear() {
+	kept := c.Sticks[:0]
+	for _, s := range c.Sticks {
+		if Dist(c.Points[s.A].Pos, c.Points[s.B].Pos) <= s.Length*c.TearRatio {
+			kept = append(kept, s)
+		}
+	}
+	c.Sticks = kept
+}
+
+// the positions of all the points, row by row, for drawing
+func (c *Cloth) Positions() []Vector {
+	ps := make([]Vector, len(c.Points))
+	for i, p := range c.Points {
+		ps[i] = p.Pos
+	}
+	return ps
+}
@@ -0,0 +1,83 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCloth(t *testing.T) {
+	t.Run("structure", func(t *testing.T) {
+		c := NewCloth(NewVector(0, 0), 4, 3, 2)
+
+		// 3x3 horizontal, 4x2 vertical and 2 shear in each of the 3x2 squares
+		if len(c.Sticks) != 9+8+12 {
+			t.Errorf("should have 29 sticks not %d", len(c.Sticks))
+		}
+		if p := c.At(3, 2); !p.Equals(NewVector(6, 4)) {
+			t.Errorf("bottom right should be (6, 4) not %v", p)
+		}
+		if len(c.Positions()) != 12 {
+			t.Errorf("should have 12 positions not %d", len(c.Positions()))
+		}
+	})
+
+	t.Run("hangs from its pins", func(t *testing.T) {
+		c := NewCloth(NewVector(0, 0), 5, 5, 1)
+		c.Pin(0, 0)
+		c.Pin(4, 0)
+		for range 300 {
+			c.Update(1.0 / 60)
+		}
+
+		if !c.At(0, 0).Equals(NewVector(0, 0)) || !c.At(4, 0).Equals(NewVector(4, 0)) {
+			t.Errorf("pins moved to %v and %v", c.At(0, 0), c.At(4, 0))
+		}
+		if p := c.At(2, 4); p.Y < 3.5 || p.Y > 5 {
+			t.Errorf("bottom middle should hang about 4 down, got %v", p)
+		}
+	})
+
+	t.Run("wind blows it", func(t *testing.T) {
+		c := NewCloth(NewVector(0, 0), 3, 3, 1)
+		c.Pin(0, 0)
+		c.Pin(2, 0)
+		c.Gravity = Vector{}
+		c.Wind = func(p Vector) Vector { return NewVector(0, 0, 5) }
+		for range 60 {
+			c.Update(1.0 / 60)
+		}
+
+		if c.At(1, 2).Z <= 0 {
+			t.Errorf("should be blown towards +z, got %v", c.At(1, 2))
+		}
+	})
+
+	t.Run("tears", func(t *testing.T) {
+		c := NewCloth(NewVector(0, 0), 4, 4, 1)
+		c.Pin(0, 0)
+		c.Pin(3, 0)
+		c.TearRatio = 1.5
+		before := len(c.Sticks)
+
+		// yank the bottom row away
+		for i := range 4 {
+			c.Points[c.index(i, 3)].Place(NewVector(float64(i), 20))
+		}
+		c.Update(1.0 / 60)
+
+		if len(c.Sticks) >= before {
+			t.Errorf("should have torn, still %d sticks", len(c.Sticks))
+		}
+		for _, s := range c.Sticks {
+			if s.A >= 12 && s.B >= 12 {
+				continue
+			}
+			if s.A >= 12 || s.B >= 12 {
+				t.Errorf("stick %d-%d to the bottom row should have torn", s.A, s.B)
+			}
+		}
+		if math.IsNaN(c.At(1, 1).X) {
+			t.Error("positions went NaN")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package vector
+
+// the label DBSCAN gives points that aren't in any cluster
+const DBSCANNoise = -1
+
+// groups points by density: points with at least minPts points (counting
+// themselves) within eps are cores, and cores within eps of each other share a
+// cluster along with the points near them
+//
+// labels[i] is the cluster of points[i], numbered from 0 in the order they're
+// found, or DBSCANNoise if it's on its own
+func DBSCAN(points []Vector, eps float64, minPts int) (labels []int) {
+	labels = make([]int, len(points))
+	if len(points) == 0 {
+		return labels
+	}
+
+	hash := NewSpatialHash(max(eps, 1e-9))
+	for _, p := range points {
+		hash.Insert(p)
+	}
+
+	const unvisited = -2
+	for i := range labels {
+		labels[i] = unvisited
+	}
+
+	cluster := 0
+	for i, p := range points {
+		if labels[i] != unvisited {
+			continue
+		}
+		neighbours := hash.Query(p, eps)
+		if len(neighbours) < minPts {
+			labels[i] = DBSCANNoise
+			continue
+		}
+
+		labels[i] = cluster
+		queue := neighbours
+		for len(queue) > 0 {
+			j := queue[0]
+			queue = queue[1:]
+
+			if labels[j] == DBSCANNoise {
+				// a border point, reachable but not a core itself
+				labels[j] = cluster
+			}
+			if labels[j] != unvisited {
+				continue
+			}
+			labels[j] = cluster
+			if more := hash.Query(points[j], eps); len(more) >= minPts {
+				queue = append(queue, more...)
+			}
+		}
+		cluster++
+	}
+	return labels
+}
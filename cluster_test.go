@@ -0,0 +1,55 @@
+package vector
+
+import "testing"
+
+func TestDBSCAN(t *testing.T) {
+	points := []Vector{
+		// a tight group
+		NewVector(0, 0), NewVector(1, 0), NewVector(0, 1), NewVector(1, 1),
+		// a chain that's connected end to end
+		NewVector(20, 0), NewVector(21.5, 0), NewVector(23, 0), NewVector(24.5, 0), NewVector(26, 0),
+		// on its own
+		NewVector(50, 50),
+		// a border point near the first group
+		NewVector(2.4, 1),
+	}
+	labels := DBSCAN(points, 1.5, 3)
+
+	for i := 1; i < 4; i++ {
+		if labels[i] != labels[0] {
+			t.Errorf("point %d should be in the first cluster, labels %v", i, labels)
+		}
+	}
+	for i := 5; i < 9; i++ {
+		if labels[i] != labels[4] {
+			t.Errorf("point %d should be in the chain's cluster, labels %v", i, labels)
+		}
+	}
+	if labels[0] != 0 || labels[4] != 1 {
+		t.Errorf("clusters should be numbered in order, got %v", labels)
+	}
+	if labels[9] != DBSCANNoise {
+		t.Errorf("the lone point should be noise, got %d", labels[9])
+	}
+	if labels[10] != labels[0] {
+		t.Errorf("the border point should join the first cluster, got %d", labels[10])
+	}
+}
+
+func TestSpatialHash(t *testing.T) {
+	h := NewSpatialHash(2)
+	for _, p := range []Vector{NewVector(0, 0), NewVector(3, 0), NewVector(-5, 0), NewVector(0, 0, 4.5)} {
+		h.Insert(p)
+	}
+
+	found := map[int]bool{}
+	for _, i := range h.Query(NewVector(0, 0), 5) {
+		found[i] = true
+	}
+	if len(found) != 4 {
+		t.Errorf("all 4 points should be within 5, found %v", found)
+	}
+	if got := h.Query(NewVector(3, 0), 1); len(got) != 1 || h.Point(got[0]) != NewVector(3, 0) {
+		t.Errorf("should only find (3, 0), got %v", got)
+	}
+}
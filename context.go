@@ -0,0 +1,104 @@
+package vector
+
+import "math"
+
+// the unit a Context takes and gives angles in, like p5's angleMode()
+type AngleMode int
+
+const (
+	AngleRadians AngleMode = iota
+	AngleDegrees
+)
+
+// which way positive angles turn
+//
+// ConventionP5, the zero value, turns positive angles from +x towards +y,
+// the same as p5's fromAngle(), rotate() and heading() (atan2(y, x)). That
+// is clockwise on a screen where y points down, and is the usual
+// counter-clockwise maths direction when y points up, so it is also called
+// ConventionMath.
+//
+// ConventionPackage turns them from +x towards -y, which is what the package
+// functions FromAngle, Rotate, SetHeading, Direction and SignedAngle2D (and
+// everything built on them, like Polar, ArcPoints and the matrix rotations)
+// do. The package's Heading follows p5 instead. Unsigned angles like
+// AngleBetween are the same either way
+type Convention int
+
+const (
+	ConventionP5 Convention = iota
+	ConventionPackage
+)
+
+// the same numbers as ConventionP5, counter-clockwise with y pointing up
+const ConventionMath = ConventionP5
+
+// angle settings for a sketch, so code ported from p5 gives the same numbers
+//
+// It's a value to pass around rather than a global so different sketches,
+// and tests, don't interfere. The zero value uses radians and p5's
+// convention, so its angles have the opposite sign to the package functions.
+// Set Convention to ConventionPackage to match them
+type Context struct {
+	AngleMode  AngleMode
+	Convention Convention
+}
+
+// converts an angle in the context's mode and convention to radians in the package's convention
+func (c Context) toPackage(a float64) float64 {
+	r := c.toRadians(a)
+	if c.Convention == ConventionP5 {
+		return -r
+	}
+	return r
+}
+
+// converts radians in the package's convention to the context's mode and convention
+func (c Context) fromPackage(r float64) float64 {
+	if c.Convention == ConventionP5 && r != math.Pi {
+		// keep the result in (-π, π]
+		r = -r
+	}
+	return c.fromRadians(r)
+}
+
+// converts an angle in the context's mode to radians
+func (c Context) toRadians(a float64) float64 {
+	if c.AngleMode == AngleDegrees {
+		return a * math.Pi / 180
+	}
+	return a
+}
+
+// converts radians to the context's mode
+func (c Context) fromRadians(r float64) float64 {
+	if c.AngleMode == AngleDegrees {
+		return r * 180 / math.Pi
+	}
+	return r
+}
+
+// FromAngle with the angle in the context's mode and convention. If length is omitted a unit vector is made
+func (c Context) FromAngle(angle float64, length ...float64) Vector {
+	return FromAngle(append([]float64{c.toPackage(angle)}, length...)...)
+}
+
+// Rotate with the angle in the context's mode and convention
+func (c Context) Rotate(v Vector, angle float64) Vector {
+	return Rotate(v, c.toPackage(angle))
+}
+
+// Heading in the context's mode and convention, in (-π, π] or (-180, 180]
+func (c Context) Heading(v Vector) float64 {
+	return c.fromPackage(heading(v))
+}
+
+// AngleBetween in the context's mode
+func (c Context) AngleBetween(v1, v2 Vector) float64 {
+	return c.fromRadians(AngleBetween(v1, v2))
+}
+
+// SignedAngle2D in the context's mode and convention
+func (c Context) SignedAngle2D(v1, v2 Vector) float64 {
+	return c.fromPackage(SignedAngle2D(v1, v2))
+}
@@ -0,0 +1,83 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	t.Run("zero value matches p5", func(t *testing.T) {
+		var c Context
+
+		// p5.Vector.fromAngle(QUARTER_PI) is (0.7071, 0.7071)
+		if v := c.FromAngle(math.Pi / 4); !v.Equals(NewVector(math.Sqrt2/2, math.Sqrt2/2)) {
+			t.Errorf("fromAngle(QUARTER_PI) should be (0.7071, 0.7071) not %v", v)
+		}
+		// createVector(0, 1).heading() is HALF_PI
+		if h := c.Heading(NewVector(0, 1)); h != math.Pi/2 {
+			t.Errorf("heading of (0, 1) should be π/2 not %f", h)
+		}
+		if v := NewVector(3, -1); c.Heading(v) != Heading(v) {
+			t.Errorf("should match Heading, %f not %f", Heading(v), c.Heading(v))
+		}
+		// createVector(1, 0).rotate(HALF_PI) is (0, 1)
+		if v := c.Rotate(NewVector(1, 0), math.Pi/2); !v.Equals(NewVector(0, 1)) {
+			t.Errorf("rotate(HALF_PI) should be (0, 1) not %v", v)
+		}
+		if h := c.Heading(NewVector(-1, 0)); h != math.Pi {
+			t.Errorf("heading should stay π not %f", h)
+		}
+	})
+
+	t.Run("package convention", func(t *testing.T) {
+		c := Context{Convention: ConventionPackage}
+		v := NewVector(3, -1)
+
+		if !c.FromAngle(0.4, 2).Equals(FromAngle(0.4, 2)) || !c.Rotate(v, 0.4).Equals(Rotate(v, 0.4)) {
+			t.Error("should match the package functions")
+		}
+		if c.Heading(v) != Direction(v).Radians() || c.SignedAngle2D(v, NewVector(1, 1)) != SignedAngle2D(v, NewVector(1, 1)) {
+			t.Errorf("heading should be %f not %f", Direction(v).Radians(), c.Heading(v))
+		}
+	})
+
+	t.Run("degrees", func(t *testing.T) {
+		c := Context{AngleMode: AngleDegrees}
+
+		if v := c.FromAngle(90, 2); !v.Equals(NewVector(0, 2)) {
+			t.Errorf("90 degrees should point along +y, (0, 2) not %v", v)
+		}
+		if v := c.FromAngle(180, 3); !v.Equals(NewVector(-3, 0)) {
+			t.Errorf("should be (-3, 0) not %v", v)
+		}
+		if v := c.Rotate(NewVector(1, 0), 90); !v.Equals(NewVector(0, 1)) {
+			t.Errorf("should turn towards +y to (0, 1) not %v", v)
+		}
+		if h := c.Heading(NewVector(0, -2)); math.Abs(h+90) > 1e-9 {
+			t.Errorf("heading should be -90 not %f", h)
+		}
+		if h := c.Heading(NewVector(-1, 0)); h != 180 {
+			t.Errorf("heading should stay 180 not %f", h)
+		}
+		if a := c.AngleBetween(NewVector(1, 0), NewVector(-1, 0)); math.Abs(a-180) > 1e-9 {
+			t.Errorf("should be 180 not %f", a)
+		}
+		if a := c.SignedAngle2D(NewVector(1, 0), NewVector(1, 1)); math.Abs(a-45) > 1e-9 {
+			t.Errorf("turning towards +y should be 45 not %f", a)
+		}
+		if h := c.Heading(c.FromAngle(-30)); math.Abs(h+30) > 1e-9 {
+			t.Errorf("should round trip -30 not %f", h)
+		}
+	})
+
+	t.Run("package convention in degrees", func(t *testing.T) {
+		c := Context{AngleMode: AngleDegrees, Convention: ConventionPackage}
+
+		if v := c.FromAngle(90); !v.Equals(FromAngle(math.Pi / 2)) {
+			t.Errorf("90 degrees should be %v not %v", FromAngle(math.Pi/2), v)
+		}
+		if h := c.Heading(NewVector(0, -2)); math.Abs(h-90) > 1e-9 {
+			t.Errorf("heading should be 90 not %f", h)
+		}
+	})
+}
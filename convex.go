@@ -0,0 +1,193 @@
+package vector
+
+import (
+	"math"
+	"slices"
+)
+
+// the convex hull of the points in the xy plane, anticlockwise (in y-up terms)
+// starting from the lowest x. Uses Andrew's monotone chain
+func ConvexHull(points []Vector) []Vector {
+	ps := slices.Clone(points)
+	slices.SortFunc(ps, func(a, b Vector) int {
+		if a.X != b.X {
+			return cmpFloat(a.X, b.X)
+		}
+		return cmpFloat(a.Y, b.Y)
+	})
+	ps = slices.CompactFunc(ps, func(a, b Vector) bool { return a.X == b.X && a.Y == b.Y })
+
+	if len(ps) < 3 {
+		return ps
+	}
+
+	hull := []Vector{}
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, p := range ps {
+			for len(hull) >= start+2 && cross2(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		// the last point is the first point of the next chain
+		hull = hull[:len(hull)-1]
+		slices.Reverse(ps)
+	}
+
+	return hull
+}
+
+// the z of the cross product (a -> b) x (a -> c). Positive when c is to the left of a -> b (y-up)
+func cross2(a, b, c Vector) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// the Minkowski sum of two convex polygons: every a + b, as a convex polygon
+func MinkowskiSum(a, b []Vector) []Vector {
+	sums := make([]Vector, 0, len(a)*len(b))
+	for _, p := range a {
+		for _, q := range b {
+			sums = append(sums, Add(p, q))
+		}
+	}
+	return ConvexHull(sums)
+}
+
+// the Minkowski difference of two convex polygons: every a - b, as a convex polygon.
+// The polygons overlap if it contains the origin
+func MinkowskiDiff(a, b []Vector) []Vector {
+	diffs := make([]Vector, 0, len(a)*len(b))
+	for _, p := range a {
+		for _, q := range b {
+			diffs = append(diffs, Sub(p, q))
+		}
+	}
+	return ConvexHull(diffs)
+}
+
+// check if two convex polygons overlap using GJK
+//
+// Shapes GJK can't settle within 64 iterations, which only happens when they
+// are all but touching, are reported as not overlapping
+func ConvexOverlap(a, b []Vector) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+
+	support := func(d Vector) Vector {
+		return Sub(furthest(a, d), furthest(b, Mult(d, -1)))
+	}
+
+	d := Sub(centroid(a), centroid(b))
+	if d.MagSq() == 0 {
+		d = NewVector(1, 0)
+	}
+
+	simplex := []Vector{support(d)}
+	d = Mult(simplex[0], -1)
+
+	for i := 0; i < 64; i++ {
+		if d.MagSq() == 0 {
+			// the origin is on the simplex
+			return true
+		}
+
+		p := support(d)
+		if p.DotProduct(d) < 0 {
+			return false
+		}
+		simplex = append(simplex, p)
+
+		var contains bool
+		simplex, d, contains = nextSimplex(simplex)
+		if contains {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reduces the simplex to the part nearest the origin and returns the next search direction
+func nextSimplex(s []Vector) ([]Vector, Vector, bool) {
+	switch len(s) {
+	case 2:
+		b, a := s[0], s[1]
+		ab := Sub(b, a)
+		ao := Mult(a, -1)
+		if ab.DotProduct(ao) > 0 {
+			return s, towardsOrigin(a, b), false
+		}
+		return []Vector{a}, ao, false
+
+	default:
+		c, b, a := s[0], s[1], s[2]
+		ab := Sub(b, a)
+		ac := Sub(c, a)
+		ao := Mult(a, -1)
+
+		abPerp := perpAway(ab, ac)
+		if abPerp.DotProduct(ao) > 0 {
+			return []Vector{b, a}, abPerp, false
+		}
+
+		acPerp := perpAway(ac, ab)
+		if acPerp.DotProduct(ao) > 0 {
+			return []Vector{c, a}, acPerp, false
+		}
+
+		return s, Vector{}, true
+	}
+}
+
+// the perpendicular to the line a-b that points towards the origin
+func towardsOrigin(a, b Vector) Vector {
+	ab := Sub(b, a)
+	n := NewVector(-ab.Y, ab.X)
+	if n.DotProduct(a) > 0 {
+		n.Mult(-1)
+	}
+	return n
+}
+
+// the perpendicular to edge pointing away from other
+func perpAway(edge, other Vector) Vector {
+	n := NewVector(-edge.Y, edge.X)
+	if n.DotProduct(other) > 0 {
+		n.Mult(-1)
+	}
+	return n
+}
+
+// the point furthest in direction d
+func furthest(ps []Vector, d Vector) Vector {
+	best := ps[0]
+	bestDot := math.Inf(-1)
+	for _, p := range ps {
+		if dp := p.DotProduct(d); dp > bestDot {
+			best = p
+			bestDot = dp
+		}
+	}
+	return best
+}
+
+// the average of the points
+func centroid(ps []Vector) Vector {
+	c := Vector{}
+	for _, p := range ps {
+		c.Add(p)
+	}
+	return Div(c, float64(len(ps)))
+}
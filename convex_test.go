@@ -0,0 +1,65 @@
+package vector
+
+import "testing"
+
+func square(x, y, size float64) []Vector {
+	return []Vector{
+		NewVector(x, y),
+		NewVector(x+size, y),
+		NewVector(x+size, y+size),
+		NewVector(x, y+size),
+	}
+}
+
+func TestConvexHull(t *testing.T) {
+	points := append(square(0, 0, 2), NewVector(1, 1), NewVector(1, 0))
+
+	h := ConvexHull(points)
+	if len(h) != 4 {
+		t.Errorf("hull should be the 4 corners not %v", h)
+	}
+}
+
+func TestMinkowski(t *testing.T) {
+	sum := MinkowskiSum(square(0, 0, 1), square(2, 2, 1))
+
+	expected := square(2, 2, 2)
+	if len(sum) != 4 {
+		t.Fatalf("sum should be a square not %v", sum)
+	}
+	for i := range sum {
+		if !sum[i].Equals(expected[i]) {
+			t.Errorf("corner %d is %v (expected %v)", i, sum[i], expected[i])
+		}
+	}
+
+	diff := MinkowskiDiff(square(0, 0, 1), square(0.5, 0.5, 1))
+	if !diff[0].Equals(NewVector(-1.5, -1.5)) || !diff[2].Equals(NewVector(0.5, 0.5)) {
+		t.Errorf("difference should be the square from {-1.5, -1.5} to {0.5, 0.5} not %v", diff)
+	}
+}
+
+func TestConvexOverlap(t *testing.T) {
+	tri := []Vector{NewVector(0, 0), NewVector(4, 0), NewVector(0, 4)}
+
+	tests := []struct {
+		name     string
+		other    []Vector
+		expected bool
+	}{
+		{"inside", square(0.5, 0.5, 1), true},
+		{"overlapping edge", square(1.5, 1.5, 2), true},
+		{"near the hypotenuse", square(2.2, 2.2, 1), false},
+		{"just clear of the hypotenuse", square(2+1e-10, 2+1e-10, 1), false},
+		{"just over the hypotenuse", square(2-1e-10, 2-1e-10, 1), true},
+		{"far away", square(10, 10, 1), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ConvexOverlap(tri, tc.other); got != tc.expected {
+				t.Errorf("overlap should be %v", tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package vector
+
+import "math"
+
+// n points evenly spaced along the arc of radius r from fromAngle to toAngle (inclusive)
+//
+// Angles go the same way as FromAngle
+func ArcPoints(center Vector, r, fromAngle, toAngle float64, n int) []Vector {
+	if n <= 0 {
+		return []Vector{}
+	}
+
+	points := make([]Vector, n)
+	for i := range points {
+		t := 0.0
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+		a := fromAngle + (toAngle-fromAngle)*t
+		points[i] = Add(center, FromAngle(a, r))
+	}
+	return points
+}
+
+// n points evenly spaced around the circle of radius r, starting on the positive x axis
+func CirclePoints(center Vector, r float64, n int) []Vector {
+	if n <= 0 {
+		return []Vector{}
+	}
+	// stop one step short so the first point isn't repeated
+	step := 2 * math.Pi / float64(n)
+	return ArcPoints(center, r, 0, step*float64(n-1), n)
+}
+
+// n points along a rope of the given length hanging between a and b
+//
+// The rope sags towards +y (down the screen). Points are evenly spaced in x.
+// If the rope is too short to sag, or a and b are directly above each other,
+// the points are on the straight line between them
+func CatenaryPoints(a, b Vector, length float64, n int) []Vector {
+	if n <= 0 {
+		return []Vector{}
+	}
+
+	straight := func() []Vector {
+		points := make([]Vector, n)
+		for i := range points {
+			t := 0.0
+			if n > 1 {
+				t = float64(i) / float64(n-1)
+			}
+			points[i] = Lerp(a, b, t)
+		}
+		return points
+	}
+
+	if length <= Dist(a, b) || a.X == b.X {
+		return straight()
+	}
+
+	// work left to right with y up so the curve is a normal U shaped catenary
+	reversed := a.X > b.X
+	if reversed {
+		a, b = b, a
+	}
+
+	h := b.X - a.X
+	v := a.Y - b.Y
+	s := math.Sqrt(length*length - v*v)
+
+	// 2c sinh(h / 2c) = s gets smaller as c grows so bisect for c
+	f := func(c float64) float64 { return 2*c*math.Sinh(h/(2*c)) - s }
+	lo, hi := h/1400, h
+	for f(hi) > 0 {
+		hi *= 2
+	}
+	for i := 0; i < 200; i++ {
+		mid := (lo + hi) / 2
+		if f(mid) > 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	c := (lo + hi) / 2
+
+	x0 := (a.X+b.X)/2 - c*math.Atanh(v/length)
+	y0 := -a.Y - c*math.Cosh((a.X-x0)/c)
+
+	points := make([]Vector, n)
+	for i := range points {
+		t := 0.0
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+		x := a.X + h*t
+		y := c*math.Cosh((x-x0)/c) + y0
+		points[i] = NewVector(x, -y)
+	}
+
+	if reversed {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+
+	return points
+}
+
+// the angle between points on ArchimedeanSpiral, 32 points per turn
+const spiralStep = math.Pi / 16
+
+// n points along the spiral r = a + b*angle, starting at angle 0 and going
+// round 32 points per turn. Angles go the same way as FromAngle
+func ArchimedeanSpiral(center Vector, a, b float64, n int) []Vector {
+	if n <= 0 {
+		return []Vector{}
+	}
+
+	points := make([]Vector, n)
+	for i := range points {
+		angle := float64(i) * spiralStep
+		points[i] = Add(center, FromAngle(angle, a+b*angle))
+	}
+	return points
+}
+
+// the golden angle in radians, π(3 - √5)
+var goldenAngle = math.Pi * (3 - math.Sqrt(5))
+
+// n points in the sunflower seed pattern (Vogel's model)
+//
+// Point i is at radius c√i and turned i golden angles round from the positive x axis
+func Phyllotaxis(center Vector, c float64, n int) []Vector {
+	if n <= 0 {
+		return []Vector{}
+	}
+
+	points := make([]Vector, n)
+	for i := range points {
+		points[i] = Add(center, FromAngle(float64(i)*goldenAngle, c*math.Sqrt(float64(i))))
+	}
+	return points
+}
+
+// the point at t on the Lissajous figure (sin(a·t + delta), sin(b·t)), which
+// fits in the square from -1 to 1
+func Lissajous(a, b, delta float64, t float64) Vector {
+	return NewVector(math.Sin(a*t+delta), math.Sin(b*t))
+}
+
+// the curve whose x and y are given by fx and fy, ready for SampleCurve
+func Parametric(fx, fy func(t float64) float64) func(t float64) Vector {
+	return func(t float64) Vector {
+		return NewVector(fx(t), fy(t))
+	}
+}
+
+// n points on the curve f for t evenly spaced from 0 to 1 (inclusive)
+func SampleCurve(f func(float64) Vector, n int) []Vector {
+	if n <= 0 {
+		return []Vector{}
+	}
+
+	points := make([]Vector, n)
+	for i := range points {
+		t := 0.0
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+		points[i] = f(t)
+	}
+	return points
+}
@@ -0,0 +1,129 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestArcPoints(t *testing.T) {
+	c := NewVector(5, 5)
+
+	t.Run("quarter arc", func(t *testing.T) {
+		ps := ArcPoints(c, 2, 0, math.Pi/2, 3)
+
+		if len(ps) != 3 {
+			t.Fatalf("should have 3 points not %d", len(ps))
+		}
+		if !ps[0].Equals(NewVector(7, 5)) || !ps[2].Equals(NewVector(5, 3)) {
+			t.Errorf("arc ends wrong %v", ps)
+		}
+	})
+
+	t.Run("circle", func(t *testing.T) {
+		ps := CirclePoints(c, 3, 8)
+
+		if len(ps) != 8 {
+			t.Fatalf("should have 8 points not %d", len(ps))
+		}
+		for _, p := range ps {
+			if !compare(t, Dist(p, c), 3) {
+				t.Errorf("%v is not on the circle", p)
+			}
+		}
+		if ps[0].Equals(ps[7]) {
+			t.Error("first point repeated")
+		}
+	})
+}
+
+func TestCatenaryPoints(t *testing.T) {
+	a := NewVector(0, 0)
+	b := NewVector(10, 2)
+
+	ps := CatenaryPoints(b, a, 15, 201)
+
+	if !ps[0].Equals(b) || !ps[200].Equals(a) {
+		t.Errorf("should hang from %v to %v not %v to %v", b, a, ps[0], ps[200])
+	}
+
+	l := 0.0
+	for i := 1; i < len(ps); i++ {
+		l += Dist(ps[i-1], ps[i])
+	}
+	if math.Abs(l-15) > 0.01 {
+		t.Errorf("rope should be 15 long not %f", l)
+	}
+
+	if ps[100].Y <= 2 {
+		t.Errorf("rope should sag below the ends %v", ps[100])
+	}
+
+	short := CatenaryPoints(a, b, 1, 3)
+	if !short[1].Equals(NewVector(5, 1)) {
+		t.Errorf("short rope should be straight %v", short)
+	}
+}
+
+func TestSpirals(t *testing.T) {
+	c := NewVector(1, 1)
+
+	t.Run("archimedean spiral grows by 2πb each turn", func(t *testing.T) {
+		ps := ArchimedeanSpiral(c, 1, 0.5, 65)
+
+		if !ps[0].Equals(NewVector(2, 1)) {
+			t.Errorf("should start at {2, 1} not %v", ps[0])
+		}
+		r1 := Dist(ps[32], c)
+		r2 := Dist(ps[64], c)
+		if !compare(t, r2-r1, math.Pi) {
+			t.Errorf("turns should be π apart not %f", r2-r1)
+		}
+	})
+
+	t.Run("phyllotaxis radius grows with √i", func(t *testing.T) {
+		ps := Phyllotaxis(c, 2, 100)
+
+		if !ps[0].Equals(c) {
+			t.Errorf("first seed should be at the centre not %v", ps[0])
+		}
+		if !compare(t, Dist(ps[25], c), 10) {
+			t.Errorf("seed 25 should be 10 out not %f", Dist(ps[25], c))
+		}
+	})
+}
+
+func TestSampleCurve(t *testing.T) {
+	t.Run("lissajous", func(t *testing.T) {
+		figure := func(t float64) Vector {
+			return Lissajous(3, 2, math.Pi/2, t*2*math.Pi)
+		}
+		points := SampleCurve(figure, 100)
+
+		if len(points) != 100 {
+			t.Fatalf("should have 100 points not %d", len(points))
+		}
+		if !points[0].Equals(NewVector(1, 0)) || !points[99].Equals(points[0]) {
+			t.Errorf("should start and end at (1, 0), got %v and %v", points[0], points[99])
+		}
+		for _, p := range points {
+			if math.Abs(p.X) > 1 || math.Abs(p.Y) > 1 {
+				t.Errorf("%v is outside the unit square", p)
+			}
+		}
+	})
+
+	t.Run("parametric", func(t *testing.T) {
+		parabola := Parametric(func(t float64) float64 { return t }, func(t float64) float64 { return t * t })
+		points := SampleCurve(parabola, 3)
+
+		expected := []Vector{NewVector(0, 0), NewVector(0.5, 0.25), NewVector(1, 1)}
+		for i := range expected {
+			if !points[i].Equals(expected[i]) {
+				t.Errorf("point %d should be %v not %v", i, expected[i], points[i])
+			}
+		}
+		if len(SampleCurve(parabola, 0)) != 0 {
+			t.Error("no points should be empty")
+		}
+	})
+}
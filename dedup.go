@@ -0,0 +1,89 @@
+package vector
+
+// removes points that are within tolerance of an earlier point, keeping the first one seen
+func Dedup(points []Vector, tolerance float64) []Vector {
+	if tolerance <= 0 {
+		tolerance = 1e-9
+	}
+	tolSq := tolerance * tolerance
+
+	buckets := map[cellKey][]Vector{}
+	out := []Vector{}
+
+	for _, p := range points {
+		k := keyFor(p, tolerance)
+
+		dup := false
+		neighbourCells(k, func(n cellKey) {
+			for _, q := range buckets[n] {
+				if MagSq(Sub(p, q)) <= tolSq {
+					dup = true
+				}
+			}
+		})
+
+		if !dup {
+			buckets[k] = append(buckets[k], p)
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
+// groups points that are connected by chains of neighbours within tolerance and
+// replaces each group with its average. Groups are returned in order of their first point
+func MergeClose(points []Vector, tolerance float64) []Vector {
+	if tolerance <= 0 {
+		tolerance = 1e-9
+	}
+	tolSq := tolerance * tolerance
+
+	parent := make([]int, len(points))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	buckets := map[cellKey][]int{}
+	for i, p := range points {
+		k := keyFor(p, tolerance)
+		neighbourCells(k, func(n cellKey) {
+			for _, j := range buckets[n] {
+				if MagSq(Sub(p, points[j])) <= tolSq {
+					a, b := find(i), find(j)
+					if a != b {
+						parent[max(a, b)] = min(a, b)
+					}
+				}
+			}
+		})
+		buckets[k] = append(buckets[k], i)
+	}
+
+	sums := map[int]Vector{}
+	counts := map[int]int{}
+	order := []int{}
+	for i, p := range points {
+		r := find(i)
+		if counts[r] == 0 {
+			order = append(order, r)
+		}
+		sums[r] = Add(sums[r], p)
+		counts[r]++
+	}
+
+	out := make([]Vector, len(order))
+	for i, r := range order {
+		out[i] = Div(sums[r], float64(counts[r]))
+	}
+
+	return out
+}
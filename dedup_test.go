@@ -0,0 +1,44 @@
+package vector
+
+import "testing"
+
+func TestDedup(t *testing.T) {
+	points := []Vector{
+		NewVector(0, 0),
+		NewVector(0.001, 0),
+		NewVector(5, 5),
+		NewVector(5, 5.0005),
+		NewVector(10, 0),
+	}
+
+	t.Run("dedup keeps the first of each group", func(t *testing.T) {
+		d := Dedup(points, 0.01)
+
+		if len(d) != 3 {
+			t.Fatalf("should have 3 points not %d %v", len(d), d)
+		}
+		if !d[0].Equals(points[0]) || !d[1].Equals(points[2]) || !d[2].Equals(points[4]) {
+			t.Errorf("wrong points kept %v", d)
+		}
+	})
+
+	t.Run("merge close averages each group", func(t *testing.T) {
+		m := MergeClose(points, 0.01)
+
+		if len(m) != 3 {
+			t.Fatalf("should have 3 points not %d %v", len(m), m)
+		}
+		if !m[0].Equals(NewVector(0.0005, 0)) || !m[1].Equals(NewVector(5, 5.00025)) {
+			t.Errorf("groups not averaged %v", m)
+		}
+	})
+
+	t.Run("merge close follows chains", func(t *testing.T) {
+		chain := []Vector{NewVector(0, 0), NewVector(1, 0), NewVector(2, 0)}
+
+		m := MergeClose(chain, 1.5)
+		if len(m) != 1 || !m[0].Equals(NewVector(1, 0)) {
+			t.Errorf("chain should merge to {1, 0} not %v", m)
+		}
+	})
+}
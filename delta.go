@@ -0,0 +1,96 @@
+package vector
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+var ErrBadDelta = errors.New("vector: malformed delta")
+
+// encodes curr as the entries that have moved more than tolerance from prev,
+// eg to broadcast entity positions each tick without resending them all
+//
+// prev should be what the receiver has, ie the result of its last DecodeDelta,
+// or small moves under the tolerance can add up. Entries past the end of
+// prev are always sent and curr can be shorter than prev
+func EncodeDelta(prev, curr []Vector, tolerance float64) []byte {
+	var changed []int
+	for i, v := range curr {
+		if i >= len(prev) || Dist(prev[i], v) > tolerance {
+			changed = append(changed, i)
+		}
+	}
+
+	buf := binary.AppendUvarint(nil, uint64(len(curr)))
+	buf = binary.AppendUvarint(buf, uint64(len(changed)))
+	last := -1
+	for _, i := range changed {
+		// indices go up so store the gap from the last one, which is usually small
+		buf = binary.AppendUvarint(buf, uint64(i-last-1))
+		last = i
+		for _, c := range [3]float64{curr[i].X, curr[i].Y, curr[i].Z} {
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(c))
+		}
+	}
+	return buf
+}
+
+// rebuilds the Vectors that EncodeDelta encoded against prev
+func DecodeDelta(prev []Vector, data []byte) ([]Vector, error) {
+	n, data, err := readUvarint(data)
+	if err != nil {
+		return nil, err
+	}
+	changed, data, err := readUvarint(data)
+	if err != nil || changed > n {
+		return nil, ErrBadDelta
+	}
+	// both counts come from data so check them against what it can hold
+	// before allocating: each change takes at least a byte of gap and 24 of
+	// components, and every entry past the end of prev has to be one of them
+	if changed > uint64(len(data)/25) || n > uint64(len(prev))+changed {
+		return nil, ErrBadDelta
+	}
+
+	out := make([]Vector, n)
+	copy(out, prev)
+	sent := make([]bool, n)
+
+	i := -1
+	for range changed {
+		var gap uint64
+		if gap, data, err = readUvarint(data); err != nil {
+			return nil, err
+		}
+		if gap >= n-uint64(i+1) || len(data) < 24 {
+			return nil, ErrBadDelta
+		}
+		i += int(gap) + 1
+		out[i] = NewVector(
+			math.Float64frombits(binary.LittleEndian.Uint64(data)),
+			math.Float64frombits(binary.LittleEndian.Uint64(data[8:])),
+			math.Float64frombits(binary.LittleEndian.Uint64(data[16:])),
+		)
+		sent[i] = true
+		data = data[24:]
+	}
+
+	if len(data) != 0 {
+		return nil, ErrBadDelta
+	}
+	for j := len(prev); j < len(out); j++ {
+		if !sent[j] {
+			return nil, ErrBadDelta
+		}
+	}
+	return out, nil
+}
+
+func readUvarint(data []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, nil, ErrBadDelta
+	}
+	return v, data[n:], nil
+}
@@ -0,0 +1,82 @@
+package vector
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestDelta(t *testing.T) {
+	prev := []Vector{NewVector(0, 0), NewVector(10, 10), NewVector(20, 20), NewVector(30, 30)}
+
+	t.Run("only changes are sent", func(t *testing.T) {
+		curr := []Vector{NewVector(0, 0), NewVector(10, 10.0001), NewVector(25, 20), NewVector(30, 30), NewVector(1, 2, 3)}
+		data := EncodeDelta(prev, curr, 0.01)
+
+		// two uvarint headers plus a gap and 24 bytes for each of the 2 changes
+		if len(data) != 2+2*25 {
+			t.Errorf("should be %d bytes not %d", 2+2*25, len(data))
+		}
+
+		got, err := DecodeDelta(prev, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []Vector{NewVector(0, 0), NewVector(10, 10), NewVector(25, 20), NewVector(30, 30), NewVector(1, 2, 3)}
+		if len(got) != len(expected) {
+			t.Fatalf("should be %v not %v", expected, got)
+		}
+		for i := range expected {
+			if got[i] != expected[i] {
+				t.Errorf("entry %d should be %v not %v", i, expected[i], got[i])
+			}
+		}
+	})
+
+	t.Run("shrinking", func(t *testing.T) {
+		got, err := DecodeDelta(prev, EncodeDelta(prev, prev[:2], 0))
+		if err != nil || len(got) != 2 || got[1] != prev[1] {
+			t.Errorf("should be the first 2 entries not %v (%v)", got, err)
+		}
+	})
+
+	t.Run("bad data", func(t *testing.T) {
+		data := EncodeDelta(prev, []Vector{NewVector(1, 1)}, 0)
+
+		if _, err := DecodeDelta(prev, data[:len(data)-1]); err != ErrBadDelta {
+			t.Errorf("cut off data should be ErrBadDelta not %v", err)
+		}
+		if _, err := DecodeDelta(nil, EncodeDelta(prev, prev, 0)[:2]); err != ErrBadDelta {
+			t.Errorf("missing entries should be ErrBadDelta not %v", err)
+		}
+
+		// huge counts with nothing after them mustn't be allocated
+		huge := binary.AppendUvarint(binary.AppendUvarint(nil, 1<<40), 1<<40)
+		if _, err := DecodeDelta(prev, huge); err != ErrBadDelta {
+			t.Errorf("huge counts should be ErrBadDelta not %v", err)
+		}
+		gap := binary.AppendUvarint(binary.AppendUvarint(binary.AppendUvarint(nil, 1), 1), math.MaxUint64)
+		if _, err := DecodeDelta(prev, append(gap, make([]byte, 24)...)); err != ErrBadDelta {
+			t.Errorf("a gap past the end should be ErrBadDelta not %v", err)
+		}
+	})
+}
+
+func FuzzDecodeDelta(f *testing.F) {
+	prev := []Vector{NewVector(0, 0), NewVector(10, 10), NewVector(20, 20)}
+	f.Add(EncodeDelta(prev, []Vector{NewVector(0, 1), NewVector(10, 10), NewVector(20, 20), NewVector(3, 4)}, 0))
+	f.Add(EncodeDelta(prev, prev[:1], 0))
+	f.Add([]byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x01})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		got, err := DecodeDelta(prev, data)
+		if err != nil {
+			return
+		}
+		// anything that decodes should encode back to something that decodes the same
+		again, err := DecodeDelta(prev, EncodeDelta(prev, got, 0))
+		if err != nil || len(again) != len(got) {
+			t.Fatalf("%v didn't round trip: %v %v", got, again, err)
+		}
+	})
+}
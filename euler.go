@@ -0,0 +1,107 @@
+package vector
+
+import "math"
+
+// the order Euler angle rotations are applied in. EulerXYZ turns about x
+// first, then y, then z, all about the fixed world axes
+type EulerOrder int
+
+const (
+	EulerXYZ EulerOrder = iota
+	EulerXZY
+	EulerYXZ
+	EulerYZX
+	EulerZXY
+	EulerZYX
+)
+
+// the axes (0 for x, 1 for y, 2 for z) in the order they're applied
+func (o EulerOrder) axes() [3]int {
+	switch o {
+	case EulerXZY:
+		return [3]int{0, 2, 1}
+	case EulerYXZ:
+		return [3]int{1, 0, 2}
+	case EulerYZX:
+		return [3]int{1, 2, 0}
+	case EulerZXY:
+		return [3]int{2, 0, 1}
+	case EulerZYX:
+		return [3]int{2, 1, 0}
+	}
+	return [3]int{0, 1, 2}
+}
+
+// 1 if the axes are an even permutation of xyz, -1 if odd
+func (o EulerOrder) parity() float64 {
+	switch o {
+	case EulerXZY, EulerYXZ, EulerZYX:
+		return -1
+	}
+	return 1
+}
+
+var eulerAxes = [3]Vector{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+// the rotation by angles.X about x, angles.Y about y and angles.Z about z, applied in order
+func EulerQuaternion(angles Vector, order EulerOrder) Quaternion {
+	a := [3]float64{angles.X, angles.Y, angles.Z}
+	q := IdentityQuaternion()
+	for _, axis := range order.axes() {
+		q = FromAxisAngle(eulerAxes[axis], a[axis]).Mul(q)
+	}
+	return q
+}
+
+// the same rotation as EulerQuaternion as a matrix
+func EulerMat4(angles Vector, order EulerOrder) Mat4 {
+	return EulerQuaternion(angles, order).Mat4()
+}
+
+// a flight sim style orientation: roll about z, then pitch about x, then yaw about y
+func FromEuler(yaw, pitch, roll float64) Quaternion {
+	return EulerQuaternion(NewVector(pitch, yaw, roll), EulerZXY)
+}
+
+// the yaw, pitch and roll that FromEuler would turn into q
+func (q Quaternion) YawPitchRoll() (yaw, pitch, roll float64) {
+	a := q.ToEuler(EulerZXY)
+	return a.Y, a.X, a.Z
+}
+
+// the Euler angles for the rotation in the given order, the inverse of EulerQuaternion
+func (q Quaternion) ToEuler(order EulerOrder) Vector {
+	return q.Mat4().ToEuler(order)
+}
+
+// the Euler angles for the rotation part of m in the given order, the
+// inverse of EulerMat4
+//
+// The middle angle is between -π/2 and π/2. At gimbal lock, when it's at
+// either end, the first angle is taken as 0
+func (m Mat4) ToEuler(order EulerOrder) Vector {
+	ax := order.axes()
+	s := order.parity()
+	// relabel the axes so the rotation is always applied x, y, z, which flips
+	// the angles' signs for odd orders
+	n := func(r, c int) float64 {
+		return m[ax[r]*4+ax[c]]
+	}
+
+	var a [3]float64
+	sinB := max(-1, min(1, -n(2, 0)))
+	a[1] = math.Asin(sinB)
+	if math.Abs(sinB) < 1-1e-9 {
+		a[0] = math.Atan2(n(2, 1), n(2, 2))
+		a[2] = math.Atan2(n(1, 0), n(0, 0))
+	} else {
+		a[2] = math.Atan2(-n(0, 1), n(1, 1))
+	}
+
+	// the matrix rotates by -angle, so the angles come out negated
+	var out [3]float64
+	for i, axis := range ax {
+		out[axis] = -s * a[i]
+	}
+	return NewVector(out[0], out[1], out[2])
+}
@@ -0,0 +1,59 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEuler(t *testing.T) {
+	orders := []EulerOrder{EulerXYZ, EulerXZY, EulerYXZ, EulerYZX, EulerZXY, EulerZYX}
+
+	t.Run("round trip in every order", func(t *testing.T) {
+		angles := NewVector(0.3, -0.8, 1.2)
+		for _, o := range orders {
+			if a := EulerQuaternion(angles, o).ToEuler(o); !a.Equals(angles) {
+				t.Errorf("order %d should give back %v not %v", o, angles, a)
+			}
+		}
+	})
+
+	t.Run("order matters", func(t *testing.T) {
+		angles := NewVector(math.Pi/2, math.Pi/2, 0)
+		v := NewVector(0, 0, 1)
+
+		xy := EulerMat4(angles, EulerXYZ).Transform(v)
+		if expected := RotateY4(math.Pi / 2).Mul(RotateX4(math.Pi / 2)).Transform(v); !xy.Equals(expected) {
+			t.Errorf("XYZ should be %v not %v", expected, xy)
+		}
+		yx := EulerMat4(angles, EulerYXZ).Transform(v)
+		if expected := RotateX4(math.Pi / 2).Mul(RotateY4(math.Pi / 2)).Transform(v); !yx.Equals(expected) {
+			t.Errorf("YXZ should be %v not %v", expected, yx)
+		}
+	})
+
+	t.Run("gimbal lock keeps the rotation", func(t *testing.T) {
+		angles := NewVector(0.4, math.Pi/2, 0.9)
+		m := EulerMat4(angles, EulerXYZ)
+		a := m.ToEuler(EulerXYZ)
+
+		if a.X != 0 {
+			t.Errorf("first angle should be 0 at gimbal lock not %f", a.X)
+		}
+		v := NewVector(1, 2, 3)
+		if r := EulerMat4(a, EulerXYZ).Transform(v); !r.Equals(m.Transform(v)) {
+			t.Errorf("should rotate to %v not %v", m.Transform(v), r)
+		}
+	})
+
+	t.Run("yaw pitch roll", func(t *testing.T) {
+		q := FromEuler(0.5, -0.2, 0.1)
+		yaw, pitch, roll := q.YawPitchRoll()
+
+		if math.Abs(yaw-0.5) > 1e-9 || math.Abs(pitch+0.2) > 1e-9 || math.Abs(roll-0.1) > 1e-9 {
+			t.Errorf("should be 0.5, -0.2, 0.1 not %f, %f, %f", yaw, pitch, roll)
+		}
+		if r := FromEuler(0.5, 0, 0).Rotate(NewVector(1, 0, 0)); !r.Equals(RotateY4(0.5).Transform(NewVector(1, 0, 0))) {
+			t.Errorf("yaw should turn about y, got %v", r)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+// Package swarm is a flock of boids built only from the vector package, kept
+// small enough to read as an example and busy enough to benchmark
+package swarm
+
+import (
+	"math"
+	"math/rand"
+
+	vector "github.com/bawgafr/vector"
+)
+
+// one member of the swarm
+type Boid struct {
+	Pos, Vel vector.Vector
+}
+
+// a flock of boids (Reynolds' separation, alignment and cohesion) flying
+// round a 2d box, wrapping from one side to the other
+//
+// Each boid only looks at the others within Radius, found with a
+// vector.SpatialHash. Weights scale the three steering forces before they are
+// limited to MaxForce
+type Swarm struct {
+	Boids  []Boid
+	Bounds vector.AABB
+
+	Radius   float64
+	MaxSpeed float64
+	MaxForce float64
+
+	Separation float64
+	Alignment  float64
+	Cohesion   float64
+}
+
+// n boids scattered across bounds flying in random directions. The same n and
+// bounds always give the same swarm so runs can be compared
+func NewSwarm(n int, bounds vector.AABB) *Swarm {
+	s := &Swarm{
+		Boids:      make([]Boid, max(n, 0)),
+		Bounds:     bounds,
+		Radius:     25,
+		MaxSpeed:   100,
+		MaxForce:   200,
+		Separation: 1.5,
+		Alignment:  1,
+		Cohesion:   1,
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	size := bounds.Size()
+	for i := range s.Boids {
+		pos := vector.NewVector(rng.Float64()*size.X, rng.Float64()*size.Y)
+		s.Boids[i] = Boid{
+			Pos: vector.Add(bounds.Min, pos),
+			Vel: vector.FromAngle(rng.Float64()*2*math.Pi, s.MaxSpeed/2),
+		}
+	}
+	return s
+}
+
+// moves every boid on by dt
+func (s *Swarm) Step(dt float64) {
+	hash := vector.NewSpatialHash(s.Radius)
+	for _, b := range s.Boids {
+		hash.Insert(b.Pos)
+	}
+
+	forces := make([]vector.Vector, len(s.Boids))
+	for i, b := range s.Boids {
+		forces[i] = s.steer(i, b, hash.Query(b.Pos, s.Radius))
+	}
+
+	for i := range s.Boids {
+		b := &s.Boids[i]
+		b.Vel.Add(vector.Mult(forces[i], dt)).Limit(s.MaxSpeed)
+		b.Pos.Add(vector.Mult(b.Vel, dt))
+		b.Pos.Wrap(s.Bounds.Min, s.Bounds.Max)
+	}
+}
+
+// the steering force on boid i from its neighbours
+func (s *Swarm) steer(i int, b Boid, neighbours []int) vector.Vector {
+	var away, heading, centre vector.Vector
+	count := 0
+	for _, j := range neighbours {
+		if j == i {
+			continue
+		}
+		other := s.Boids[j]
+		offset := vector.Sub(b.Pos, other.Pos)
+		if d := offset.MagSq(); d > 0 {
+			// push harder the closer they are
+			away.Add(vector.Div(offset, d))
+		}
+		heading.Add(other.Vel)
+		centre.Add(other.Pos)
+		count++
+	}
+	if count == 0 {
+		return vector.Vector{}
+	}
+
+	force := vector.Mult(s.desire(b, away), s.Separation)
+	force.Add(vector.Mult(s.desire(b, heading), s.Alignment))
+	force.Add(vector.Mult(vector.Seek(b.Pos, b.Vel, vector.Div(centre, float64(count)), s.MaxSpeed), s.Cohesion))
+	return *force.Limit(s.MaxForce)
+}
+
+// the force turning b to fly at full speed in direction dir
+func (s *Swarm) desire(b Boid, dir vector.Vector) vector.Vector {
+	if dir.MagSq() == 0 {
+		return vector.Vector{}
+	}
+	return vector.Sub(vector.SetMag(dir, s.MaxSpeed), b.Vel)
+}
+
+// where every boid is, for drawing
+func (s *Swarm) Positions() []vector.Vector {
+	ps := make([]vector.Vector, len(s.Boids))
+	for i, b := range s.Boids {
+		ps[i] = b.Pos
+	}
+	return ps
+}
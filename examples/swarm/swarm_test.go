@@ -0,0 +1,67 @@
+package swarm
+
+import (
+	"testing"
+
+	vector "github.com/bawgafr/vector"
+)
+
+var bounds = vector.NewAABB(vector.NewVector(0, 0), vector.NewVector(400, 300))
+
+func TestSwarm(t *testing.T) {
+	t.Run("same every time", func(t *testing.T) {
+		a, b := NewSwarm(50, bounds), NewSwarm(50, bounds)
+		for range 10 {
+			a.Step(1.0 / 60)
+			b.Step(1.0 / 60)
+		}
+
+		pa, pb := a.Positions(), b.Positions()
+		if len(pa) != 50 {
+			t.Fatalf("should have 50 positions not %d", len(pa))
+		}
+		for i := range pa {
+			if pa[i] != pb[i] {
+				t.Fatalf("boid %d is at %v and %v", i, pa[i], pb[i])
+			}
+		}
+	})
+
+	t.Run("stays in bounds", func(t *testing.T) {
+		s := NewSwarm(200, bounds)
+		for range 300 {
+			s.Step(1.0 / 60)
+		}
+
+		for _, b := range s.Boids {
+			if !bounds.Contains(b.Pos) {
+				t.Errorf("%v has left the box", b.Pos)
+			}
+			if b.Vel.Mag() > s.MaxSpeed+1e-9 {
+				t.Errorf("%v is faster than %f", b.Vel, s.MaxSpeed)
+			}
+		}
+	})
+
+	t.Run("flocks", func(t *testing.T) {
+		// boids near each other should end up flying much the same way
+		s := NewSwarm(2, bounds)
+		s.Boids[0] = Boid{vector.NewVector(100, 100), vector.NewVector(50, 0)}
+		s.Boids[1] = Boid{vector.NewVector(110, 100), vector.NewVector(0, 50)}
+		for range 60 {
+			s.Step(1.0 / 60)
+		}
+
+		if a := vector.AngleBetween(s.Boids[0].Vel, s.Boids[1].Vel); a > 0.2 {
+			t.Errorf("should be heading the same way, still %f apart", a)
+		}
+	})
+}
+
+func BenchmarkStep(b *testing.B) {
+	s := NewSwarm(1000, vector.NewAABB(vector.NewVector(0, 0), vector.NewVector(1000, 1000)))
+	b.ResetTimer()
+	for range b.N {
+		s.Step(1.0 / 60)
+	}
+}
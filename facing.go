@@ -0,0 +1,64 @@
+package vector
+
+import "math"
+
+// a compass direction for picking sprites, named for a y-down screen where
+// Up is towards -y. The values go round the same way as FromAngle, an eighth
+// of a turn apart, starting with FacingRight along +x
+type Facing int
+
+const (
+	FacingRight Facing = iota
+	FacingUpRight
+	FacingUp
+	FacingUpLeft
+	FacingLeft
+	FacingDownLeft
+	FacingDown
+	FacingDownRight
+)
+
+var facingNames = [...]string{"right", "up-right", "up", "up-left", "left", "down-left", "down", "down-right"}
+
+func (f Facing) String() string {
+	if f < 0 || int(f) >= len(facingNames) {
+		return "unknown"
+	}
+	return facingNames[f]
+}
+
+// the unit vector pointing the way of f
+func (f Facing) Vector() Vector {
+	return FromAngle(float64(f) * math.Pi / 4)
+}
+
+// the nearest of right, up, left and down to the heading of v. A zero vector faces right
+func FacingFrom(v Vector) Facing {
+	return Facing(2 * headingSector(heading(v), 4))
+}
+
+// the nearest of the eight compass directions to the heading of v. A zero vector faces right
+func Facing8From(v Vector) Facing {
+	return Facing(headingSector(heading(v), 8))
+}
+
+// v turned to the nearest of directions evenly spaced headings, starting
+// along +x, without changing its magnitude. Fewer than 1 direction leaves v alone
+func SnapHeadingTo(v Vector, directions int) Vector {
+	if directions < 1 {
+		return v
+	}
+	step := 2 * math.Pi / float64(directions)
+	s := v
+	s.SetHeading(float64(headingSector(heading(v), directions)) * step)
+	return s
+}
+
+// which of n equal sectors, centred on 0, step, 2*step..., the heading h is in
+func headingSector(h float64, n int) int {
+	i := int(math.Round(h/(2*math.Pi/float64(n)))) % n
+	if i < 0 {
+		i += n
+	}
+	return i
+}
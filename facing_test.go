@@ -0,0 +1,52 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFacing(t *testing.T) {
+	for _, tc := range []struct {
+		v     Vector
+		four  Facing
+		eight Facing
+		name8 string
+	}{
+		{NewVector(5, 0.5), FacingRight, FacingRight, "right"},
+		{NewVector(1, -1.2), FacingUp, FacingUpRight, "up-right"},
+		{NewVector(-0.1, -3), FacingUp, FacingUp, "up"},
+		{NewVector(-2, 0.3), FacingLeft, FacingLeft, "left"},
+		{NewVector(-1, 1), FacingLeft, FacingDownLeft, "down-left"},
+		{NewVector(0.2, 4), FacingDown, FacingDown, "down"},
+		{NewVector(2, 1.5), FacingRight, FacingDownRight, "down-right"},
+	} {
+		if f := FacingFrom(tc.v); f != tc.four {
+			t.Errorf("%v should face %v not %v", tc.v, tc.four, f)
+		}
+		if f := Facing8From(tc.v); f != tc.eight || f.String() != tc.name8 {
+			t.Errorf("%v should face %s not %v", tc.v, tc.name8, f)
+		}
+	}
+
+	if v := FacingUp.Vector(); !v.Equals(NewVector(0, -1)) {
+		t.Errorf("up should be (0, -1) not %v", v)
+	}
+}
+
+func TestSnapHeadingTo(t *testing.T) {
+	v := FromAngle(1, 3)
+	s := SnapHeadingTo(v, 4)
+
+	if !s.Equals(FromAngle(math.Pi/2, 3)) {
+		t.Errorf("should snap to a quarter turn, got %v", s)
+	}
+	if s := SnapHeadingTo(v, 8); !s.Equals(FromAngle(math.Pi/4, 3)) {
+		t.Errorf("should snap to an eighth turn, got %v", s)
+	}
+	if s := SnapHeadingTo(FromAngle(-3, 2), 6); !s.Equals(FromAngle(math.Pi, 2)) {
+		t.Errorf("should snap round to π, got %v", s)
+	}
+	if s := SnapHeadingTo(v, 0); !s.Equals(v) {
+		t.Errorf("no directions should leave it alone, got %v", s)
+	}
+}
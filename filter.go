@@ -0,0 +1,231 @@
+package vector
+
+import (
+	"math"
+	"time"
+)
+
+// an alpha-beta filter for smoothing a noisy stream of positions, assuming
+// the thing being tracked moves at a roughly constant velocity
+//
+// Alpha (0 to 1) is how much each measurement corrects the position and Beta
+// (0 to 2) how much it corrects the velocity. Smaller values smooth more but lag more
+type Filter2D struct {
+	Alpha, Beta float64
+
+	pos, vel Vector
+	started  bool
+}
+
+// creates a Filter2D
+func NewFilter2D(alpha, beta float64) *Filter2D {
+	return &Filter2D{Alpha: alpha, Beta: beta}
+}
+
+// feeds in a measurement taken dt after the last one and returns the filtered position and velocity
+//
+// The first measurement is taken as is with zero velocity
+func (f *Filter2D) Update(measurement Vector, dt float64) (pos, vel Vector) {
+	if !f.started {
+		f.pos = measurement
+		f.vel = Vector{}
+		f.started = true
+		return f.pos, f.vel
+	}
+
+	predicted := Add(f.pos, Mult(f.vel, dt))
+	residual := Sub(measurement, predicted)
+
+	f.pos = Add(predicted, Mult(residual, f.Alpha))
+	if dt > 0 {
+		f.vel = Add(f.vel, Mult(residual, f.Beta/dt))
+	}
+
+	return f.pos, f.vel
+}
+
+// forgets the history so the next measurement starts again
+func (f *Filter2D) Reset() {
+	f.started = false
+}
+
+// the 1€ filter (Casiez et al.) for smoothing pointer and drawing input
+//
+// It smooths heavily when the input is slow, to remove jitter, and less when
+// it's fast, to cut lag. MinCutoff (Hz) sets the smoothing when still and
+// Beta how quickly the smoothing backs off with speed. DCutoff (Hz) smooths
+// the speed estimate itself
+type OneEuroFilter struct {
+	MinCutoff, Beta, DCutoff float64
+
+	value, deriv Vector
+	started      bool
+}
+
+// creates a OneEuroFilter with DCutoff set to 1Hz
+func NewOneEuroFilter(minCutoff, beta float64) *OneEuroFilter {
+	return &OneEuroFilter{MinCutoff: minCutoff, Beta: beta, DCutoff: 1}
+}
+
+// feeds in a sample taken dt seconds after the last one and returns the filtered value
+func (f *OneEuroFilter) Update(v Vector, dt float64) Vector {
+	if !f.started || dt <= 0 {
+		if !f.started {
+			f.value = v
+			f.deriv = Vector{}
+			f.started = true
+		}
+		return f.value
+	}
+
+	d := Div(Sub(v, f.value), dt)
+	f.deriv = Lerp(f.deriv, d, smoothingAlpha(f.DCutoff, dt))
+
+	cutoff := f.MinCutoff + f.Beta*f.deriv.Mag()
+	f.value = Lerp(f.value, v, smoothingAlpha(cutoff, dt))
+
+	return f.value
+}
+
+// forgets the history so the next sample starts again
+func (f *OneEuroFilter) Reset() {
+	f.started = false
+}
+
+// the exponential smoothing factor for a low pass filter with the cutoff frequency (Hz)
+func smoothingAlpha(cutoff, dt float64) float64 {
+	tau := 1 / (2 * math.Pi * cutoff)
+	return 1 / (1 + tau/dt)
+}
+
+// an exponential moving average of a stream of vectors
+//
+// If HalfLife is set a value's influence halves every HalfLife updates, or
+// every HalfLife seconds when fed through UpdateDt, so the smoothing doesn't
+// depend on the frame rate. Otherwise each update moves the average Alpha
+// (0 to 1) of the way to the new value
+type EMA struct {
+	Alpha    float64
+	HalfLife float64
+
+	value   Vector
+	started bool
+}
+
+// creates an EMA that moves Alpha of the way to each new value
+func NewEMA(alpha float64) *EMA {
+	return &EMA{Alpha: alpha}
+}
+
+// creates an EMA where a value's influence halves every halfLife updates (or seconds, with UpdateDt)
+func NewEMAHalfLife(halfLife float64) *EMA {
+	return &EMA{HalfLife: halfLife}
+}
+
+// adds a value and returns the new average. The first value is taken as is
+func (e *EMA) Update(v Vector) Vector {
+	return e.UpdateDt(v, 1)
+}
+
+// adds a value taken dt seconds after the last one and returns the new
+// average, with HalfLife counted in seconds. Without a HalfLife it's the same as Update
+func (e *EMA) UpdateDt(v Vector, dt float64) Vector {
+	if !e.started {
+		e.value = v
+		e.started = true
+		return e.value
+	}
+
+	alpha := e.Alpha
+	if e.HalfLife > 0 {
+		alpha = 1 - math.Pow(2, -max(0, dt)/e.HalfLife)
+	}
+	e.value.Lerp(v, alpha)
+	return e.value
+}
+
+// the current average
+func (e *EMA) Value() Vector {
+	return e.value
+}
+
+// forgets the history so the next value starts again
+func (e *EMA) Reset() {
+	e.started = false
+}
+
+// estimates velocity and acceleration from timestamped position samples, eg
+// for working out the speed of a fling at the end of a drag
+//
+// Finite differences between samples are smoothed with a time constant of
+// Smoothing, and the acceleration is the smoothed rate of change of the
+// smoothed velocity. Larger values are steadier but slower to respond
+type VelocityEstimator struct {
+	Smoothing time.Duration
+
+	pos        Vector
+	at         time.Time
+	vel, accel Vector
+	samples    int
+}
+
+// creates a VelocityEstimator
+func NewVelocityEstimator(smoothing time.Duration) *VelocityEstimator {
+	return &VelocityEstimator{Smoothing: smoothing}
+}
+
+// adds a position sample. Samples that are not after the last one are ignored
+func (e *VelocityEstimator) Add(p Vector, at time.Time) {
+	if e.samples > 0 && !at.After(e.at) {
+		return
+	}
+
+	if e.samples == 0 {
+		e.pos = p
+		e.at = at
+		e.samples++
+		return
+	}
+
+	dt := at.Sub(e.at).Seconds()
+	vel := Div(Sub(p, e.pos), dt)
+
+	alpha := 1.0
+	if e.Smoothing > 0 {
+		alpha = 1 - math.Exp(-dt/e.Smoothing.Seconds())
+	}
+
+	if e.samples == 1 {
+		e.vel = vel
+	} else {
+		prev := e.vel
+		e.vel.Lerp(vel, alpha)
+
+		// the change in the smoothed velocity, smoothed again
+		accel := Div(Sub(e.vel, prev), dt)
+		if e.samples == 2 {
+			e.accel = accel
+		} else {
+			e.accel.Lerp(accel, alpha)
+		}
+	}
+
+	e.pos = p
+	e.at = at
+	e.samples++
+}
+
+// the smoothed velocity in units per second
+func (e *VelocityEstimator) Velocity() Vector {
+	return e.vel
+}
+
+// the smoothed acceleration in units per second per second
+func (e *VelocityEstimator) Acceleration() Vector {
+	return e.accel
+}
+
+// forgets all the samples
+func (e *VelocityEstimator) Reset() {
+	*e = VelocityEstimator{Smoothing: e.Smoothing}
+}
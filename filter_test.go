@@ -0,0 +1,193 @@
+package vector
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestFilter2D(t *testing.T) {
+	t.Run("first measurement is taken as is", func(t *testing.T) {
+		f := NewFilter2D(0.5, 0.1)
+
+		pos, vel := f.Update(NewVector(3, 4), 0.1)
+		if !pos.Equals(NewVector(3, 4)) || !vel.Equals(Vector{}) {
+			t.Errorf("should start at {3, 4} standing still not %v %v", pos, vel)
+		}
+	})
+
+	t.Run("tracks a noisy constant velocity", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+		f := NewFilter2D(0.3, 0.05)
+		velocity := NewVector(10, -5)
+		dt := 0.1
+
+		var pos, vel Vector
+		for i := 0; i < 300; i++ {
+			truth := Mult(velocity, float64(i)*dt)
+			noise := NewVector(r.Float64()-0.5, r.Float64()-0.5)
+			pos, vel = f.Update(Add(truth, noise), dt)
+		}
+
+		if Dist(vel, velocity) > 1 {
+			t.Errorf("velocity should be near %v not %v", velocity, vel)
+		}
+		if Dist(pos, Mult(velocity, 299*dt)) > 0.5 {
+			t.Errorf("position %v has drifted", pos)
+		}
+	})
+}
+
+func TestOneEuroFilter(t *testing.T) {
+	t.Run("smooths jitter when still", func(t *testing.T) {
+		r := rand.New(rand.NewSource(3))
+		f := NewOneEuroFilter(1, 0.01)
+
+		var out Vector
+		for i := 0; i < 200; i++ {
+			noise := NewVector(r.Float64()-0.5, r.Float64()-0.5)
+			out = f.Update(Add(NewVector(50, 50), noise), 1.0/60)
+		}
+
+		if Dist(out, NewVector(50, 50)) > 0.1 {
+			t.Errorf("should settle near {50, 50} not %v", out)
+		}
+	})
+
+	t.Run("less lag when moving fast", func(t *testing.T) {
+		slow := NewOneEuroFilter(1, 0)
+		fast := NewOneEuroFilter(1, 1)
+
+		var s, f Vector
+		for i := 0; i < 30; i++ {
+			p := NewVector(float64(i)*20, 0)
+			s = slow.Update(p, 1.0/60)
+			f = fast.Update(p, 1.0/60)
+		}
+
+		target := NewVector(29*20, 0)
+		if Dist(f, target) >= Dist(s, target) {
+			t.Errorf("beta should reduce lag: %v vs %v", f, s)
+		}
+	})
+}
+
+func TestEMA(t *testing.T) {
+	t.Run("moves alpha of the way", func(t *testing.T) {
+		e := NewEMA(0.25)
+		e.Update(NewVector(0, 0))
+
+		if v := e.Update(NewVector(8, -4)); !v.Equals(NewVector(2, -1)) {
+			t.Errorf("should be {2, -1} not %v", v)
+		}
+	})
+
+	t.Run("half life in updates", func(t *testing.T) {
+		e := NewEMAHalfLife(3)
+		e.Update(NewVector(0, 0))
+
+		var v Vector
+		for range 3 {
+			v = e.Update(NewVector(100, 0))
+		}
+
+		if !compare(t, v.X, 50) {
+			t.Errorf("should be half way after three updates not %v", v)
+		}
+	})
+
+	t.Run("half life in seconds", func(t *testing.T) {
+		e := NewEMAHalfLife(0.5)
+		e.UpdateDt(NewVector(0, 0), 0)
+
+		var v Vector
+		for i := 0; i < 10; i++ {
+			v = e.UpdateDt(NewVector(100, 0), 0.05)
+		}
+
+		if !compare(t, v.X, 50) {
+			t.Errorf("should be half way after one half life not %v", v)
+		}
+	})
+
+	t.Run("half life doesn't depend on the frame rate", func(t *testing.T) {
+		slow, fast := NewEMAHalfLife(2), NewEMAHalfLife(2)
+		slow.UpdateDt(Vector{}, 0)
+		fast.UpdateDt(Vector{}, 0)
+
+		for range 30 {
+			slow.UpdateDt(NewVector(10, 20), 1.0/30)
+		}
+		for range 144 {
+			fast.UpdateDt(NewVector(10, 20), 1.0/144)
+		}
+
+		if !slow.Value().Equals(fast.Value()) {
+			t.Errorf("a second at 30 and 144 fps should match: %v vs %v", slow.Value(), fast.Value())
+		}
+	})
+
+	t.Run("alpha ignores dt", func(t *testing.T) {
+		a, b := NewEMA(0.5), NewEMA(0.5)
+		a.Update(Vector{})
+		b.UpdateDt(Vector{}, 0)
+
+		if u, w := a.Update(NewVector(4, 0)), b.UpdateDt(NewVector(4, 0), 0.2); !u.Equals(w) {
+			t.Errorf("should match without a half life: %v vs %v", u, w)
+		}
+	})
+}
+
+func TestVelocityEstimator(t *testing.T) {
+	start := time.Unix(1000, 0)
+
+	t.Run("constant velocity", func(t *testing.T) {
+		e := NewVelocityEstimator(50 * time.Millisecond)
+		for i := 0; i < 20; i++ {
+			at := start.Add(time.Duration(i) * 10 * time.Millisecond)
+			e.Add(NewVector(float64(i), float64(-2*i)), at)
+		}
+
+		if !e.Velocity().Equals(NewVector(100, -200)) {
+			t.Errorf("velocity should be {100, -200} not %v", e.Velocity())
+		}
+		if !e.Acceleration().Equals(Vector{}) {
+			t.Errorf("acceleration should be zero not %v", e.Acceleration())
+		}
+	})
+
+	t.Run("constant acceleration", func(t *testing.T) {
+		e := NewVelocityEstimator(0)
+		for i := 0; i < 10; i++ {
+			s := float64(i) / 10
+			e.Add(NewVector(3*s*s), start.Add(time.Duration(i)*100*time.Millisecond))
+		}
+
+		if !compare(t, e.Acceleration().X, 6) {
+			t.Errorf("acceleration should be 6 not %v", e.Acceleration())
+		}
+	})
+
+	t.Run("constant acceleration with smoothing", func(t *testing.T) {
+		e := NewVelocityEstimator(50 * time.Millisecond)
+		for i := 0; i < 300; i++ {
+			s := float64(i) / 100
+			e.Add(NewVector(3*s*s, -s*s), start.Add(time.Duration(i)*10*time.Millisecond))
+		}
+
+		if a := e.Acceleration(); Dist(a, NewVector(6, -2)) > 1e-6 {
+			t.Errorf("acceleration should settle on {6, -2} not %v", a)
+		}
+	})
+
+	t.Run("ignores out of order samples", func(t *testing.T) {
+		e := NewVelocityEstimator(0)
+		e.Add(NewVector(0), start)
+		e.Add(NewVector(1), start.Add(time.Second))
+		e.Add(NewVector(100), start)
+
+		if !e.Velocity().Equals(NewVector(1)) {
+			t.Errorf("velocity should be {1, 0} not %v", e.Velocity())
+		}
+	})
+}
@@ -0,0 +1,200 @@
+// Package fluid is a small stable fluids solver (Jos Stam, 1999) on the
+// root package's grids, for smoke-like effects and flow fields
+//
+// Velocity and Density share a layout, with node (i, j) at
+// Origin + (i*CellSize, j*CellSize). The edges of the grid are solid walls
+package fluid
+
+import (
+	"math"
+
+	vector "github.com/bawgafr/vector"
+)
+
+// a velocity field and a density carried along by it
+//
+// Viscosity and Diffusion are how quickly velocity and density spread out.
+// Iterations is how many relaxation passes the diffusion and pressure solves take
+type Fluid struct {
+	Velocity   *vector.Grid2D
+	Density    *vector.ScalarGrid2D
+	Viscosity  float64
+	Diffusion  float64
+	Iterations int
+}
+
+// creates a still, empty fluid
+func NewFluid(cols, rows int, cellSize float64) *Fluid {
+	return &Fluid{
+		Velocity:   vector.NewGrid2D(cols, rows, cellSize),
+		Density:    vector.NewScalarGrid2D(cols, rows, cellSize),
+		Iterations: 20,
+	}
+}
+
+// the node nearest the world position p, and whether it's on the grid
+func (f *Fluid) node(p vector.Vector) (int, int, bool) {
+	g := f.Velocity
+	i := int(math.Round((p.X - g.Origin.X) / g.CellSize))
+	j := int(math.Round((p.Y - g.Origin.Y) / g.CellSize))
+	return i, j, i >= 0 && j >= 0 && i < g.Cols && j < g.Rows
+}
+
+// adds the velocity change force to the node nearest p
+func (f *Fluid) AddForce(p, force vector.Vector) {
+	if i, j, ok := f.node(p); ok {
+		f.Velocity.Set(i, j, vector.Add(f.Velocity.At(i, j), force))
+	}
+}
+
+// adds amount of density to the node nearest p
+func (f *Fluid) AddDensity(p vector.Vector, amount float64) {
+	if i, j, ok := f.node(p); ok {
+		f.Density.Set(i, j, f.Density.At(i, j)+amount)
+	}
+}
+
+// moves the fluid on by dt: the velocity diffuses, is made divergence free
+// and carries itself along, then the density diffuses and is carried by the velocity
+func (f *Fluid) Step(dt float64) {
+	f.diffuseVelocity(dt)
+	f.project()
+	f.advectVelocity(dt)
+	f.project()
+
+	f.diffuseDensity(dt)
+	f.advectDensity(dt)
+}
+
+// the implicit diffusion weight for rate over dt
+func (f *Fluid) diffusionFactor(rate, dt float64) float64 {
+	h := f.Velocity.CellSize
+	return dt * rate / (h * h)
+}
+
+func (f *Fluid) diffuseVelocity(dt float64) {
+	a := f.diffusionFactor(f.Viscosity, dt)
+	if a == 0 {
+		return
+	}
+	g := f.Velocity
+	start := copyGrid(g)
+	for range f.Iterations {
+		for j := 0; j < g.Rows; j++ {
+			for i := 0; i < g.Cols; i++ {
+				sum := vector.Add(vector.Add(g.At(i-1, j), g.At(i+1, j)), vector.Add(g.At(i, j-1), g.At(i, j+1)))
+				g.Set(i, j, vector.Div(vector.Add(start.At(i, j), vector.Mult(sum, a)), 1+4*a))
+			}
+		}
+		f.walls()
+	}
+}
+
+func (f *Fluid) diffuseDensity(dt float64) {
+	a := f.diffusionFactor(f.Diffusion, dt)
+	if a == 0 {
+		return
+	}
+	s := f.Density
+	start := copyScalar(s)
+	for range f.Iterations {
+		for j := 0; j < s.Rows; j++ {
+			for i := 0; i < s.Cols; i++ {
+				sum := s.At(i-1, j) + s.At(i+1, j) + s.At(i, j-1) + s.At(i, j+1)
+				s.Set(i, j, (start.At(i, j)+a*sum)/(1+4*a))
+			}
+		}
+	}
+}
+
+// traces each node back along the velocity and takes what was there
+func (f *Fluid) advectVelocity(dt float64) {
+	g := f.Velocity
+	start := copyGrid(g)
+	for j := 0; j < g.Rows; j++ {
+		for i := 0; i < g.Cols; i++ {
+			from := vector.Sub(g.Pos(i, j), vector.Mult(start.At(i, j), dt))
+			g.Set(i, j, start.SampleBilinear(from))
+		}
+	}
+	f.walls()
+}
+
+func (f *Fluid) advectDensity(dt float64) {
+	s, g := f.Density, f.Velocity
+	start := copyScalar(s)
+	for j := 0; j < s.Rows; j++ {
+		for i := 0; i < s.Cols; i++ {
+			from := vector.Sub(g.Pos(i, j), vector.Mult(g.At(i, j), dt))
+			s.Set(i, j, start.SampleBilinear(from))
+		}
+	}
+}
+
+// removes the divergence from the velocity by solving for the pressure and
+// taking away its gradient
+func (f *Fluid) project() {
+	g := f.Velocity
+	div := g.Divergence()
+	pressure := vector.NewScalarGrid2D(g.Cols, g.Rows, g.CellSize)
+	pressure.Origin = g.Origin
+	h2 := g.CellSize * g.CellSize
+
+	for range f.Iterations {
+		for j := 0; j < g.Rows; j++ {
+			for i := 0; i < g.Cols; i++ {
+				sum := pressure.At(i-1, j) + pressure.At(i+1, j) + pressure.At(i, j-1) + pressure.At(i, j+1)
+				pressure.Set(i, j, (sum-div.At(i, j)*h2)/4)
+			}
+		}
+	}
+
+	grad := pressure.Gradient()
+	for j := 0; j < g.Rows; j++ {
+		for i := 0; i < g.Cols; i++ {
+			g.Set(i, j, vector.Sub(g.At(i, j), grad.At(i, j)))
+		}
+	}
+	f.walls()
+}
+
+// stops flow through the edges of the grid
+func (f *Fluid) walls() {
+	g := f.Velocity
+	for j := 0; j < g.Rows; j++ {
+		for _, i := range []int{0, g.Cols - 1} {
+			v := g.At(i, j)
+			v.X = 0
+			g.Set(i, j, v)
+		}
+	}
+	for i := 0; i < g.Cols; i++ {
+		for _, j := range []int{0, g.Rows - 1} {
+			v := g.At(i, j)
+			v.Y = 0
+			g.Set(i, j, v)
+		}
+	}
+}
+
+func copyGrid(g *vector.Grid2D) *vector.Grid2D {
+	c := vector.NewGrid2D(g.Cols, g.Rows, g.CellSize)
+	c.Origin = g.Origin
+	for j := 0; j < g.Rows; j++ {
+		for i := 0; i < g.Cols; i++ {
+			c.Set(i, j, g.At(i, j))
+		}
+	}
+	return c
+}
+
+func copyScalar(s *vector.ScalarGrid2D) *vector.ScalarGrid2D {
+	c := vector.NewScalarGrid2D(s.Cols, s.Rows, s.CellSize)
+	c.Origin = s.Origin
+	for j := 0; j < s.Rows; j++ {
+		for i := 0; i < s.Cols; i++ {
+			c.Set(i, j, s.At(i, j))
+		}
+	}
+	return c
+}
@@ -0,0 +1,89 @@
+package fluid
+
+import (
+	"math"
+	"testing"
+
+	vector "github.com/bawgafr/vector"
+)
+
+func total(s *vector.ScalarGrid2D) float64 {
+	sum := 0.0
+	for j := 0; j < s.Rows; j++ {
+		for i := 0; i < s.Cols; i++ {
+			sum += s.At(i, j)
+		}
+	}
+	return sum
+}
+
+func TestFluid(t *testing.T) {
+	t.Run("density is carried by a force", func(t *testing.T) {
+		f := NewFluid(32, 32, 1)
+		f.AddDensity(vector.NewVector(10, 16), 100)
+		for range 20 {
+			for dy := -2.0; dy <= 2; dy++ {
+				f.AddForce(vector.NewVector(10, 16+dy), vector.NewVector(10, 0))
+			}
+			f.Step(0.1)
+		}
+
+		// the centre of mass of the density should have moved towards +x
+		var sum float64
+		var moment vector.Vector
+		for j := 0; j < 32; j++ {
+			for i := 0; i < 32; i++ {
+				d := f.Density.At(i, j)
+				sum += d
+				moment.Add(vector.Mult(f.Velocity.Pos(i, j), d))
+			}
+		}
+		if c := vector.Div(moment, sum); c.X <= 12 {
+			t.Errorf("density should have moved well right of x = 10, centre is %v", c)
+		}
+	})
+
+	t.Run("diffusion keeps the total in the middle", func(t *testing.T) {
+		f := NewFluid(21, 21, 1)
+		f.Diffusion = 0.5
+		f.AddDensity(vector.NewVector(10, 10), 50)
+		for range 5 {
+			f.Step(0.1)
+		}
+
+		if d := total(f.Density); math.Abs(d-50) > 0.5 {
+			t.Errorf("total density should stay about 50 not %f", d)
+		}
+		if f.Density.At(10, 10) >= 50 || f.Density.At(11, 10) <= 0 {
+			t.Error("density should have spread to the neighbours")
+		}
+	})
+
+	t.Run("projection removes divergence", func(t *testing.T) {
+		f := NewFluid(32, 32, 1)
+		f.Iterations = 200
+		// a smooth source pushing outwards from the middle
+		for j := 0; j < 32; j++ {
+			for i := 0; i < 32; i++ {
+				d := vector.NewVector(float64(i-16), float64(j-16))
+				f.Velocity.Set(i, j, vector.Mult(d, math.Exp(-d.MagSq()/16)))
+			}
+		}
+		before := sumAbs(f.Velocity.Divergence())
+		f.project()
+
+		if after := sumAbs(f.Velocity.Divergence()); after > before/4 {
+			t.Errorf("divergence should drop a lot, %f to %f", before, after)
+		}
+	})
+}
+
+func sumAbs(s *vector.ScalarGrid2D) float64 {
+	sum := 0.0
+	for j := 0; j < s.Rows; j++ {
+		for i := 0; i < s.Cols; i++ {
+			sum += math.Abs(s.At(i, j))
+		}
+	}
+	return sum
+}
@@ -0,0 +1,67 @@
+package vector
+
+import "math"
+
+// the steering force to keep an agent at offset from a moving leader
+//
+// offset is in the leader's frame: X is ahead of the leader and Y is to its
+// right (the +y side when the leader faces +x). The agent aims for where that
+// slot will be by the time it gets there and slows down within slowRadius of
+// it, as Arrive does
+func OffsetPursue(leaderPos, leaderVel, offset Vector, selfPos, selfVel Vector, maxSpeed, slowRadius float64) Vector {
+	target := Add(leaderPos, toLeaderFrame(leaderVel, offset))
+
+	// predict where the slot will be
+	closing := maxSpeed + leaderVel.Mag()
+	if closing > 0 {
+		t := Dist(selfPos, target) / closing
+		target.Add(Mult(leaderVel, t))
+	}
+
+	return Arrive(selfPos, selfVel, target, maxSpeed, slowRadius)
+}
+
+// turns an offset in the leader's frame into world space. A stationary leader faces +x
+func toLeaderFrame(leaderVel, offset Vector) Vector {
+	forward := NewVector(1, 0)
+	if leaderVel.MagSq() > 0 {
+		forward = Normalise(NewVector(leaderVel.X, leaderVel.Y))
+	}
+	right := NewVector(-forward.Y, forward.X)
+
+	return Add(Mult(forward, offset.X), Mult(right, offset.Y))
+}
+
+// n slots side by side in a line spacing behind the leader
+func FormationLine(n int, spacing float64) []Vector {
+	slots := make([]Vector, n)
+	width := float64(n-1) * spacing
+	for i := range slots {
+		slots[i] = NewVector(-spacing, float64(i)*spacing-width/2)
+	}
+	return slots
+}
+
+// n slots in a V behind the leader, filling alternate sides of each row
+func FormationWedge(n int, spacing float64) []Vector {
+	slots := make([]Vector, n)
+	for i := range slots {
+		row := float64(i/2 + 1)
+		side := 1.0
+		if i%2 == 1 {
+			side = -1.0
+		}
+		slots[i] = NewVector(-row*spacing, side*row*spacing)
+	}
+	return slots
+}
+
+// n slots evenly spaced around the leader at radius r, starting behind it
+func FormationCircle(n int, r float64) []Vector {
+	slots := make([]Vector, n)
+	for i := range slots {
+		a := math.Pi + 2*math.Pi*float64(i)/float64(n)
+		slots[i] = NewVector(r*math.Cos(a), r*math.Sin(a))
+	}
+	return slots
+}
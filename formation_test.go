@@ -0,0 +1,59 @@
+package vector
+
+import "testing"
+
+func TestOffsetPursue(t *testing.T) {
+	t.Run("slot is rotated with the leader", func(t *testing.T) {
+		w := toLeaderFrame(NewVector(0, 3), NewVector(-2, 1))
+
+		if !w.Equals(NewVector(-1, -2)) {
+			t.Errorf("offset should be {-1, -2} in world space not %v", w)
+		}
+	})
+
+	t.Run("already in the slot", func(t *testing.T) {
+		s := OffsetPursue(NewVector(10, 10), NewVector(), NewVector(-5, 0), NewVector(5, 10), NewVector(), 3, 4)
+
+		if !s.Equals(Vector{}) {
+			t.Errorf("should not need to steer %v", s)
+		}
+	})
+
+	t.Run("heads for the slot", func(t *testing.T) {
+		s := OffsetPursue(NewVector(10, 10), NewVector(), NewVector(-5, 0), NewVector(5, 30), NewVector(), 3, 4)
+
+		if !s.Equals(NewVector(0, -3)) {
+			t.Errorf("should head up to the slot at full speed not %v", s)
+		}
+	})
+
+	t.Run("slows inside the slow radius", func(t *testing.T) {
+		s := OffsetPursue(NewVector(10, 10), NewVector(), NewVector(-5, 0), NewVector(5, 15), NewVector(), 3, 10)
+
+		if !s.Equals(NewVector(0, -1.5)) {
+			t.Errorf("should be at half speed half way into the radius not %v", s)
+		}
+	})
+}
+
+func TestFormations(t *testing.T) {
+	line := FormationLine(3, 2)
+	if !line[0].Equals(NewVector(-2, -2)) || !line[2].Equals(NewVector(-2, 2)) {
+		t.Errorf("line slots wrong %v", line)
+	}
+
+	wedge := FormationWedge(4, 1)
+	if !wedge[1].Equals(NewVector(-1, -1)) || !wedge[2].Equals(NewVector(-2, 2)) {
+		t.Errorf("wedge slots wrong %v", wedge)
+	}
+
+	circle := FormationCircle(4, 5)
+	for _, s := range circle {
+		if !compare(t, s.Mag(), 5) {
+			t.Errorf("circle slot %v not on the circle", s)
+		}
+	}
+	if !circle[0].Equals(NewVector(-5, 0)) {
+		t.Errorf("first circle slot should be behind the leader not %v", circle[0])
+	}
+}
@@ -0,0 +1,105 @@
+package vector
+
+// a plane of points p where Normal . p + D = 0
+type Plane struct {
+	Normal Vector
+	D      float64
+}
+
+// creates the plane through point with the given normal
+func NewPlane(normal, point Vector) Plane {
+	n := Normalise(normal)
+	return Plane{n, -n.DotProduct(point)}
+}
+
+// the signed distance from the plane to p, positive on the side the normal points to
+func (pl Plane) Dist(p Vector) float64 {
+	return pl.Normal.DotProduct(p) + pl.D
+}
+
+// scales the plane so the normal is a unit vector
+func (pl Plane) normalised() Plane {
+	m := pl.Normal.Mag()
+	if m == 0 {
+		return pl
+	}
+	return Plane{Div(pl.Normal, m), pl.D / m}
+}
+
+// a viewing volume bounded by six planes whose normals point inwards
+type Frustum struct {
+	Planes [6]Plane
+}
+
+// creates a frustum from six inward facing planes (in any order)
+func NewFrustum(planes [6]Plane) Frustum {
+	f := Frustum{}
+	for i, p := range planes {
+		f.Planes[i] = p.normalised()
+	}
+	return f
+}
+
+// extracts the frustum from a view-projection matrix (Gribb and Hartmann)
+//
+// Points inside are those that project into the OpenGL clip cube, -w <= x, y, z <= w
+func FrustumFromMatrix(m Mat4) Frustum {
+	row := func(r int) (float64, float64, float64, float64) {
+		return m[r*4], m[r*4+1], m[r*4+2], m[r*4+3]
+	}
+	plane := func(sign float64, r int) Plane {
+		x, y, z, w := row(r)
+		wx, wy, wz, ww := row(3)
+		return Plane{NewVector(wx+sign*x, wy+sign*y, wz+sign*z), ww + sign*w}
+	}
+
+	return NewFrustum([6]Plane{
+		plane(1, 0), plane(-1, 0),
+		plane(1, 1), plane(-1, 1),
+		plane(1, 2), plane(-1, 2),
+	})
+}
+
+// check if the point is inside the frustum
+func (f Frustum) ContainsPoint(p Vector) bool {
+	for _, pl := range f.Planes {
+		if pl.Dist(p) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// check if any part of the sphere might be inside the frustum.
+// Spheres near the corners can be reported as intersecting when they aren't
+func (f Frustum) IntersectsSphere(center Vector, r float64) bool {
+	for _, pl := range f.Planes {
+		if pl.Dist(center) < -r {
+			return false
+		}
+	}
+	return true
+}
+
+// check if any part of the box might be inside the frustum.
+// Boxes near the corners can be reported as intersecting when they aren't
+func (f Frustum) IntersectsAABB(box AABB) bool {
+	for _, pl := range f.Planes {
+		// the corner furthest along the normal
+		p := box.Min
+		if pl.Normal.X >= 0 {
+			p.X = box.Max.X
+		}
+		if pl.Normal.Y >= 0 {
+			p.Y = box.Max.Y
+		}
+		if pl.Normal.Z >= 0 {
+			p.Z = box.Max.Z
+		}
+
+		if pl.Dist(p) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+// a unit cube from {0,0,0} to {1,1,1} as a frustum
+func cubeFrustum() Frustum {
+	return NewFrustum([6]Plane{
+		NewPlane(NewVector(1, 0, 0), NewVector(0, 0, 0)),
+		NewPlane(NewVector(-1, 0, 0), NewVector(1, 0, 0)),
+		NewPlane(NewVector(0, 1, 0), NewVector(0, 0, 0)),
+		NewPlane(NewVector(0, -1, 0), NewVector(0, 1, 0)),
+		{NewVector(0, 0, 2), 0},
+		{NewVector(0, 0, -3), 3},
+	})
+}
+
+func TestFrustum(t *testing.T) {
+	f := cubeFrustum()
+
+	t.Run("points", func(t *testing.T) {
+		if !f.ContainsPoint(NewVector(0.5, 0.5, 0.5)) {
+			t.Error("should contain the centre")
+		}
+		if f.ContainsPoint(NewVector(0.5, 0.5, 1.5)) {
+			t.Error("should not contain a point past the far plane")
+		}
+	})
+
+	t.Run("spheres", func(t *testing.T) {
+		if !f.IntersectsSphere(NewVector(1.4, 0.5, 0.5), 0.5) {
+			t.Error("should intersect a sphere poking through the side")
+		}
+		if f.IntersectsSphere(NewVector(2, 0.5, 0.5), 0.5) {
+			t.Error("should not intersect a sphere outside")
+		}
+	})
+
+	t.Run("boxes", func(t *testing.T) {
+		if !f.IntersectsAABB(NewAABB(NewVector(-1, -1, -1), NewVector(0.1, 0.1, 0.1))) {
+			t.Error("should intersect an overlapping box")
+		}
+		if f.IntersectsAABB(NewAABB(NewVector(-1, -1, -1), NewVector(-0.1, 2, 2))) {
+			t.Error("should not intersect a box off to the side")
+		}
+	})
+}
+
+func TestFrustumFromMatrix(t *testing.T) {
+	// looking down -z from the origin with a 90° field of view
+	f := FrustumFromMatrix(Perspective4(math.Pi/2, 1, 1, 100))
+
+	if !f.ContainsPoint(NewVector(0, 0, -10)) {
+		t.Error("should contain a point straight ahead")
+	}
+	if !f.ContainsPoint(NewVector(9, -9, -10)) {
+		t.Error("should contain a point just inside the corner")
+	}
+	if f.ContainsPoint(NewVector(11, 0, -10)) {
+		t.Error("should not contain a point outside the field of view")
+	}
+	if f.ContainsPoint(NewVector(0, 0, 10)) || f.ContainsPoint(NewVector(0, 0, -0.5)) || f.ContainsPoint(NewVector(0, 0, -101)) {
+		t.Error("should not contain points behind, before the near plane or after the far plane")
+	}
+}
@@ -0,0 +1,59 @@
+package generic
+
+// GLSL style swizzles. Each returns a new vector made from the named
+// components in order, with any missing ones left as 0
+
+// the vector (x, y, 0)
+func (v Vector[T]) XY() Vector[T] {
+	return Vector[T]{X: v.X, Y: v.Y}
+}
+
+// the vector (x, z, 0)
+func (v Vector[T]) XZ() Vector[T] {
+	return Vector[T]{X: v.X, Y: v.Z}
+}
+
+// the vector (y, x, 0)
+func (v Vector[T]) YX() Vector[T] {
+	return Vector[T]{X: v.Y, Y: v.X}
+}
+
+// the vector (y, z, 0)
+func (v Vector[T]) YZ() Vector[T] {
+	return Vector[T]{X: v.Y, Y: v.Z}
+}
+
+// the vector (z, x, 0)
+func (v Vector[T]) ZX() Vector[T] {
+	return Vector[T]{X: v.Z, Y: v.X}
+}
+
+// the vector (z, y, 0)
+func (v Vector[T]) ZY() Vector[T] {
+	return Vector[T]{X: v.Z, Y: v.Y}
+}
+
+// the vector (x, z, y)
+func (v Vector[T]) XZY() Vector[T] {
+	return Vector[T]{v.X, v.Z, v.Y}
+}
+
+// the vector (y, x, z)
+func (v Vector[T]) YXZ() Vector[T] {
+	return Vector[T]{v.Y, v.X, v.Z}
+}
+
+// the vector (y, z, x)
+func (v Vector[T]) YZX() Vector[T] {
+	return Vector[T]{v.Y, v.Z, v.X}
+}
+
+// the vector (z, x, y)
+func (v Vector[T]) ZXY() Vector[T] {
+	return Vector[T]{v.Z, v.X, v.Y}
+}
+
+// the vector (z, y, x)
+func (v Vector[T]) ZYX() Vector[T] {
+	return Vector[T]{v.Z, v.Y, v.X}
+}
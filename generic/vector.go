@@ -0,0 +1,746 @@
+// Package generic is the Vector API over float32 or float64 components
+//
+// It mirrors the root vector package so code targeting float32 (WebAssembly,
+// GPU buffers) doesn't convert every component on every frame. Angles follow
+// the same convention as the root package: Heading is p5's atan2(y, x), while
+// FromAngle, Rotate and SetHeading turn from +x towards -y.
+package generic
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"unsafe"
+
+	vector "github.com/bawgafr/vector"
+)
+
+// the component types a Vector can have
+type Float interface {
+	~float32 | ~float64
+}
+
+// the methods with pointer receivers change the vector in place and return
+// it so calls can be chained, eg v.Add(a).Mult(0.5).Limit(maxSpeed)
+type Vector[T Float] struct {
+	X, Y, Z T
+}
+
+// a Vector with float32 components
+type Vector32 = Vector[float32]
+
+// a Vector with float64 components
+type Vector64 = Vector[float64]
+
+func (v Vector[T]) String() string {
+	return fmt.Sprintf("{%2f, %2f, %2f}", v.X, v.Y, v.Z)
+}
+
+// the tolerance used by Equals, looser for float32 which only has ~7 digits
+func epsilon[T Float]() T {
+	var zero T
+	if unsafe.Sizeof(zero) == 4 {
+		return T(1e-5)
+	}
+	return T(1e-9)
+}
+
+func abs[T Float](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func sqrt[T Float](x T) T {
+	return T(math.Sqrt(float64(x)))
+}
+
+// converts from the root package's float64 Vector
+func From[T Float](v vector.Vector) Vector[T] {
+	return Vector[T]{T(v.X), T(v.Y), T(v.Z)}
+}
+
+// converts to the root package's float64 Vector
+func (v Vector[T]) Vector() vector.Vector {
+	return vector.Vector{X: float64(v.X), Y: float64(v.Y), Z: float64(v.Z)}
+}
+
+// check if the components of the two vectors are the same
+func Equals[T Float](v1, v2 Vector[T]) bool {
+	e := epsilon[T]()
+	return abs(v1.X-v2.X) < e && abs(v1.Y-v2.Y) < e && abs(v1.Z-v2.Z) < e
+}
+
+// check if the passed Vector has the same components as this Vector
+func (v Vector[T]) Equals(other Vector[T]) bool {
+	return Equals(v, other)
+}
+
+// the tolerance passed to a predicate, or the one Equals uses for T
+func tolerance[T Float](tol []T) T {
+	if len(tol) > 0 {
+		return tol[0]
+	}
+	return epsilon[T]()
+}
+
+// check if v is within tolerance of the zero vector
+func IsZero[T Float](v Vector[T], tol ...T) bool {
+	return v.Mag() <= tolerance(tol)
+}
+
+// check if this vector is within tolerance of the zero vector
+func (v Vector[T]) IsZero(tol ...T) bool {
+	return IsZero(v, tol...)
+}
+
+// check if the magnitude of v is within tolerance of 1
+func IsUnit[T Float](v Vector[T], tol ...T) bool {
+	return abs(v.Mag()-1) <= tolerance(tol)
+}
+
+// check if this vector's magnitude is within tolerance of 1
+func (v Vector[T]) IsUnit(tol ...T) bool {
+	return IsUnit(v, tol...)
+}
+
+// check if v1 and v2 point the same or opposite ways, tolerance being on the sine of the angle between them
+func IsParallel[T Float](v1, v2 Vector[T], tol ...T) bool {
+	return Cross(v1, v2).Mag() <= tolerance(tol)*v1.Mag()*v2.Mag()
+}
+
+// check if this vector and other point the same or opposite ways
+func (v Vector[T]) IsParallel(other Vector[T], tol ...T) bool {
+	return IsParallel(v, other, tol...)
+}
+
+// check if v1 and v2 are at right angles, tolerance being on the cosine of the angle between them
+func IsPerpendicular[T Float](v1, v2 Vector[T], tol ...T) bool {
+	return abs(DotProduct(v1, v2)) <= tolerance(tol)*v1.Mag()*v2.Mag()
+}
+
+// check if this vector and other are at right angles
+func (v Vector[T]) IsPerpendicular(other Vector[T], tol ...T) bool {
+	return IsPerpendicular(v, other, tol...)
+}
+
+// creates a vector from up to 3 components, missing ones are 0
+func NewVector[T Float](values ...T) Vector[T] {
+	var v Vector[T]
+	v.Set(values...)
+	return v
+}
+
+// create a unit vector in a random direction
+func Random2d[T Float]() Vector[T] {
+	return FromAngle[T](T(rand.Float64() * 2 * math.Pi))
+}
+
+// create a unit vector in a random 3d direction
+func Random3d[T Float]() Vector[T] {
+	v := NewVector(T(rand.Float64()), T(rand.Float64()), T(rand.Float64()))
+	v.Normalise()
+	return v
+}
+
+// sets the values of the components
+//
+// Set() will set the components to {0,0,0}
+// Set(a) will set {a, 0, 0}.
+// Set(a,b) will set {a, b, 0}.
+// Set(a, b, c) will set {a, b, c}
+func (v *Vector[T]) Set(values ...T) *Vector[T] {
+	l := len(values)
+
+	if l == 0 {
+		v.X, v.Y, v.Z = 0, 0, 0
+	}
+	if l > 0 {
+		v.X = values[0]
+	}
+	if l > 1 {
+		v.Y = values[1]
+	}
+	if l > 2 {
+		v.Z = values[2]
+	}
+
+	return v
+}
+
+// returns a new copy of the vector
+func (v Vector[T]) Copy() Vector[T] {
+	return v
+}
+
+// adds the two vectors and retuns a new Vector
+func Add[T Float](v1, v2 Vector[T]) Vector[T] {
+	return Vector[T]{v1.X + v2.X, v1.Y + v2.Y, v1.Z + v2.Z}
+}
+
+// adds the vector to this one
+func (v *Vector[T]) Add(other Vector[T]) *Vector[T] {
+	*v = Add(*v, other)
+	return v
+}
+
+// subtract the two vectors and return a new Vector
+func Sub[T Float](v1, v2 Vector[T]) Vector[T] {
+	return Vector[T]{v1.X - v2.X, v1.Y - v2.Y, v1.Z - v2.Z}
+}
+
+// subtract the vector from this one
+func (v *Vector[T]) Sub(other Vector[T]) *Vector[T] {
+	*v = Sub(*v, other)
+	return v
+}
+
+// component-wise remainder of v1 / v2. Zero components of v2 leave v1 unchanged
+func Rem[T Float](v1, v2 Vector[T]) Vector[T] {
+	return Vector[T]{rem(v1.X, v2.X), rem(v1.Y, v2.Y), rem(v1.Z, v2.Z)}
+}
+
+// replaces each component of this vector with its remainder after dividing by other
+func (v *Vector[T]) Rem(other Vector[T]) *Vector[T] {
+	*v = Rem(*v, other)
+	return v
+}
+
+func rem[T Float](a, b T) T {
+	if b == 0 {
+		return a
+	}
+	return T(math.Mod(float64(a), float64(b)))
+}
+
+// multiply the vector by m and return a new Vector
+func Mult[T Float](v Vector[T], m T) Vector[T] {
+	return Vector[T]{v.X * m, v.Y * m, v.Z * m}
+}
+
+// multiply this vector by m
+func (v *Vector[T]) Mult(m T) *Vector[T] {
+	*v = Mult(*v, m)
+	return v
+}
+
+// scalar divide the vector by d
+func Div[T Float](v Vector[T], d T) Vector[T] {
+	return Vector[T]{v.X / d, v.Y / d, v.Z / d}
+}
+
+// scalar divide this by amount d
+func (v *Vector[T]) Div(d T) *Vector[T] {
+	*v = Div(*v, d)
+	return v
+}
+
+// multiply each component of v by the matching one of m
+func MultVec[T Float](v, m Vector[T]) Vector[T] {
+	return Vector[T]{v.X * m.X, v.Y * m.Y, v.Z * m.Z}
+}
+
+// multiply each component of this vector by the matching one of m
+func (v *Vector[T]) MultVec(m Vector[T]) *Vector[T] {
+	*v = MultVec(*v, m)
+	return v
+}
+
+// divide each component of v by the matching one of d. Components divided by 0 are left alone
+func DivVec[T Float](v, d Vector[T]) Vector[T] {
+	return Vector[T]{div(v.X, d.X), div(v.Y, d.Y), div(v.Z, d.Z)}
+}
+
+// divide each component of this vector by the matching one of d
+func (v *Vector[T]) DivVec(d Vector[T]) *Vector[T] {
+	*v = DivVec(*v, d)
+	return v
+}
+
+func div[T Float](a, b T) T {
+	if b == 0 {
+		return a
+	}
+	return a / b
+}
+
+// returns the magnitude of the passed in Vector
+func Mag[T Float](v Vector[T]) T {
+	return sqrt(MagSq(v))
+}
+
+// returns the magnitude squared of the passed Vector
+func MagSq[T Float](v Vector[T]) T {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+}
+
+// return the magnitude squared of this vector
+func (v Vector[T]) MagSq() T {
+	return MagSq(v)
+}
+
+// return the magnitude of this vector
+func (v Vector[T]) Mag() T {
+	return Mag(v)
+}
+
+// angle between 2 vectors
+func AngleBetween[T Float](v1, v2 Vector[T]) T {
+	cos := float64(DotProduct(v1, v2)) / (float64(v1.Mag()) * float64(v2.Mag()))
+	return T(math.Acos(max(-1, min(1, cos))))
+}
+
+// angle between passed in vector and this vector
+func (v Vector[T]) AngleBetween(other Vector[T]) T {
+	return AngleBetween(v, other)
+}
+
+// the signed angle to turn v1 by to point along v2 in 2d, in (-π, π]
+func SignedAngle2D[T Float](v1, v2 Vector[T]) T {
+	return T(vector.SignedAngle2D(v1.Vector(), v2.Vector()))
+}
+
+// the signed angle to turn this vector by to point along other in 2d
+func (v Vector[T]) SignedAngle2D(other Vector[T]) T {
+	return SignedAngle2D(v, other)
+}
+
+// returns the dot product of the Vectors
+func DotProduct[T Float](v1, v2 Vector[T]) T {
+	return v1.X*v2.X + v1.Y*v2.Y + v1.Z*v2.Z
+}
+
+// returns the dot product of this vector with the passed in one
+func (v Vector[T]) DotProduct(other Vector[T]) T {
+	return DotProduct(v, other)
+}
+
+// returns the cross product of the Vectors
+func Cross[T Float](v1, v2 Vector[T]) Vector[T] {
+	return Vector[T]{
+		v1.Y*v2.Z - v1.Z*v2.Y,
+		v1.Z*v2.X - v1.X*v2.Z,
+		v1.X*v2.Y - v1.Y*v2.X,
+	}
+}
+
+// a · (b × c), the signed volume of the parallelepiped with edges a, b and c
+func TripleProductScalar[T Float](a, b, c Vector[T]) T {
+	return DotProduct(a, Cross(b, c))
+}
+
+// a × (b × c), which lies in the plane of b and c
+func TripleProductVector[T Float](a, b, c Vector[T]) Vector[T] {
+	return Cross(a, Cross(b, c))
+}
+
+// returns the cross product of this vector with the passed in one
+func (v Vector[T]) Cross(other Vector[T]) Vector[T] {
+	return Cross(v, other)
+}
+
+// Distance between the two vectors
+func Dist[T Float](v1, v2 Vector[T]) T {
+	return Mag(Sub(v1, v2))
+}
+
+// distance between this vector and one passed in
+func (v Vector[T]) Dist(other Vector[T]) T {
+	return Dist(v, other)
+}
+
+// reflects v off a surface with the passed normal
+func Reflect[T Float](v, normal Vector[T]) Vector[T] {
+	n := Normalise(normal)
+	return Sub(v, Mult(n, 2*v.DotProduct(n)))
+}
+
+// reflects this vector off a surface with the passed normal
+func (v *Vector[T]) Reflect(normal Vector[T]) *Vector[T] {
+	*v = Reflect(*v, normal)
+	return v
+}
+
+// the part of v that points along other (vector projection)
+func ProjectOnto[T Float](v, other Vector[T]) Vector[T] {
+	m := other.MagSq()
+	if m == 0 {
+		return Vector[T]{}
+	}
+	return Mult(other, v.DotProduct(other)/m)
+}
+
+// replaces this vector with the part of it that points along other
+func (v *Vector[T]) ProjectOnto(other Vector[T]) *Vector[T] {
+	*v = ProjectOnto(*v, other)
+	return v
+}
+
+// the signed length of v along the direction of onto (scalar projection)
+func ScalarProject[T Float](v, onto Vector[T]) T {
+	m := onto.Mag()
+	if m == 0 {
+		return 0
+	}
+	return v.DotProduct(onto) / m
+}
+
+// the signed length of this vector along the direction of onto
+func (v Vector[T]) ScalarProject(onto Vector[T]) T {
+	return ScalarProject(v, onto)
+}
+
+// the part of v that is perpendicular to other (vector rejection)
+func RejectFrom[T Float](v, other Vector[T]) Vector[T] {
+	return Sub(v, ProjectOnto(v, other))
+}
+
+// replaces this vector with the part of it that is perpendicular to other
+func (v *Vector[T]) RejectFrom(other Vector[T]) *Vector[T] {
+	*v = RejectFrom(*v, other)
+	return v
+}
+
+// normalise the vector
+func Normalise[T Float](v Vector[T]) Vector[T] {
+	return Div(v, v.Mag())
+}
+
+// normalise this vector
+func (v *Vector[T]) Normalise() *Vector[T] {
+	*v = Normalise(*v)
+	return v
+}
+
+// limits the magnitude of the vector to l
+func Limit[T Float](v Vector[T], l T) Vector[T] {
+	m := v.Mag()
+	if m <= l {
+		return v
+	}
+	return Mult(v, l/m)
+}
+
+// limit the magnitude of this vector
+func (v *Vector[T]) Limit(l T) *Vector[T] {
+	*v = Limit(*v, l)
+	return v
+}
+
+// keeps the magnitude of the vector between lo and hi. A zero vector stays zero
+func ClampMag[T Float](v Vector[T], lo, hi T) Vector[T] {
+	m := v.Mag()
+	if m == 0 || (m >= lo && m <= hi) {
+		return v
+	}
+	return Mult(v, max(lo, min(m, hi))/m)
+}
+
+// keeps the magnitude of this vector between lo and hi
+func (v *Vector[T]) ClampMag(lo, hi T) *Vector[T] {
+	*v = ClampMag(*v, lo, hi)
+	return v
+}
+
+// the smaller of each component of v1 and v2
+func Min[T Float](v1, v2 Vector[T]) Vector[T] {
+	return Vector[T]{min(v1.X, v2.X), min(v1.Y, v2.Y), min(v1.Z, v2.Z)}
+}
+
+// sets each component of this vector to the smaller of it and other's
+func (v *Vector[T]) Min(other Vector[T]) *Vector[T] {
+	*v = Min(*v, other)
+	return v
+}
+
+// the larger of each component of v1 and v2
+func Max[T Float](v1, v2 Vector[T]) Vector[T] {
+	return Vector[T]{max(v1.X, v2.X), max(v1.Y, v2.Y), max(v1.Z, v2.Z)}
+}
+
+// sets each component of this vector to the larger of it and other's
+func (v *Vector[T]) Max(other Vector[T]) *Vector[T] {
+	*v = Max(*v, other)
+	return v
+}
+
+// keeps each component of v between the matching components of lo and hi
+func Clamp[T Float](v, lo, hi Vector[T]) Vector[T] {
+	return Min(Max(v, lo), hi)
+}
+
+// keeps each component of this vector between those of lo and hi
+func (v *Vector[T]) Clamp(lo, hi Vector[T]) *Vector[T] {
+	*v = Clamp(*v, lo, hi)
+	return v
+}
+
+// wraps each component of v into the range from lo (included) to hi (not
+// included). Components with an empty range become lo's
+func Wrap[T Float](v, lo, hi Vector[T]) Vector[T] {
+	return Vector[T]{wrap(v.X, lo.X, hi.X), wrap(v.Y, lo.Y, hi.Y), wrap(v.Z, lo.Z, hi.Z)}
+}
+
+// wraps each component of this vector into the range from lo to hi
+func (v *Vector[T]) Wrap(lo, hi Vector[T]) *Vector[T] {
+	*v = Wrap(*v, lo, hi)
+	return v
+}
+
+func wrap[T Float](a, lo, hi T) T {
+	size := hi - lo
+	if size <= 0 {
+		return lo
+	}
+	a = T(math.Mod(float64(a-lo), float64(size)))
+	if a < 0 {
+		a += size
+	}
+	return lo + a
+}
+
+// the absolute value of each component of v
+func Abs[T Float](v Vector[T]) Vector[T] {
+	return Vector[T]{T(math.Abs(float64(v.X))), T(math.Abs(float64(v.Y))), T(math.Abs(float64(v.Z)))}
+}
+
+// makes each component of this vector positive
+func (v *Vector[T]) Abs() *Vector[T] {
+	*v = Abs(*v)
+	return v
+}
+
+// v pointing the opposite way
+func Negate[T Float](v Vector[T]) Vector[T] {
+	return Vector[T]{-v.X, -v.Y, -v.Z}
+}
+
+// points this vector the opposite way
+func (v *Vector[T]) Negate() *Vector[T] {
+	*v = Negate(*v)
+	return v
+}
+
+// each component of v rounded down
+func Floor[T Float](v Vector[T]) Vector[T] {
+	return Vector[T]{T(math.Floor(float64(v.X))), T(math.Floor(float64(v.Y))), T(math.Floor(float64(v.Z)))}
+}
+
+// rounds each component of this vector down
+func (v *Vector[T]) Floor() *Vector[T] {
+	*v = Floor(*v)
+	return v
+}
+
+// each component of v rounded up
+func Ceil[T Float](v Vector[T]) Vector[T] {
+	return Vector[T]{T(math.Ceil(float64(v.X))), T(math.Ceil(float64(v.Y))), T(math.Ceil(float64(v.Z)))}
+}
+
+// rounds each component of this vector up
+func (v *Vector[T]) Ceil() *Vector[T] {
+	*v = Ceil(*v)
+	return v
+}
+
+// each component of v rounded to the nearest whole number, halves away from zero
+func Round[T Float](v Vector[T]) Vector[T] {
+	return Vector[T]{T(math.Round(float64(v.X))), T(math.Round(float64(v.Y))), T(math.Round(float64(v.Z)))}
+}
+
+// rounds each component of this vector to the nearest whole number
+func (v *Vector[T]) Round() *Vector[T] {
+	*v = Round(*v)
+	return v
+}
+
+// set magnitude of the vector
+func SetMag[T Float](v Vector[T], m T) Vector[T] {
+	return Mult(Normalise(v), m)
+}
+
+// set the magnitude of this vector
+func (v *Vector[T]) SetMag(m T) *Vector[T] {
+	*v = SetMag(*v, m)
+	return v
+}
+
+// angle 2d vector makes with the positive x axis, in (-π, π], atan2(y, x) as p5 and the root package give it
+func Heading[T Float](v Vector[T]) T {
+	return T(vector.Heading(v.Vector()))
+}
+
+// angle this 2d vector makes with the positive x axis, in (-π, π]
+func (v Vector[T]) Heading() T {
+	return Heading(v)
+}
+
+// returns a copy of the 2d vector turned to point at angle, without changing its magnitude
+func SetHeading[T Float](v Vector[T], angle T) Vector[T] {
+	h := FromAngle(angle, sqrt(v.X*v.X+v.Y*v.Y))
+	return Vector[T]{h.X, h.Y, v.Z}
+}
+
+// turns this 2d vector to point at angle, without changing its magnitude
+func (v *Vector[T]) SetHeading(angle T) *Vector[T] {
+	*v = SetHeading(*v, angle)
+	return v
+}
+
+// rotates the 2d vector by angle and returns a new Vector
+func Rotate[T Float](v Vector[T], angle T) Vector[T] {
+	c := T(math.Cos(-float64(angle)))
+	s := T(math.Sin(-float64(angle)))
+
+	return Vector[T]{c*v.X - s*v.Y, s*v.X + c*v.Y, 0}
+}
+
+// rotates the vector by angle
+func (v *Vector[T]) Rotate(angle T) *Vector[T] {
+	z := v.Z
+	*v = Rotate(*v, angle)
+	v.Z = z
+	return v
+}
+
+// rotates v by angle about axis (which doesn't need to be a unit vector) using
+// Rodrigues' formula. It turns the same way as Rotate does about +z
+func RotateAxis[T Float](v, axis Vector[T], angle T) Vector[T] {
+	m := axis.Mag()
+	if m == 0 {
+		return v
+	}
+	k := Div(axis, m)
+	c := T(math.Cos(-float64(angle)))
+	s := T(math.Sin(-float64(angle)))
+
+	r := Add(Mult(v, c), Mult(Cross(k, v), s))
+	return Add(r, Mult(k, DotProduct(k, v)*(1-c)))
+}
+
+// rotates the vector by angle about axis
+func (v *Vector[T]) RotateAxis(axis Vector[T], angle T) *Vector[T] {
+	*v = RotateAxis(*v, axis, angle)
+	return v
+}
+
+// rotates v by angle about the x axis, turning +y towards -z
+func RotateX[T Float](v Vector[T], angle T) Vector[T] {
+	c := T(math.Cos(-float64(angle)))
+	s := T(math.Sin(-float64(angle)))
+	return Vector[T]{v.X, c*v.Y - s*v.Z, s*v.Y + c*v.Z}
+}
+
+// rotates the vector by angle about the x axis
+func (v *Vector[T]) RotateX(angle T) *Vector[T] {
+	*v = RotateX(*v, angle)
+	return v
+}
+
+// rotates v by angle about the y axis, turning +z towards -x
+func RotateY[T Float](v Vector[T], angle T) Vector[T] {
+	c := T(math.Cos(-float64(angle)))
+	s := T(math.Sin(-float64(angle)))
+	return Vector[T]{c*v.X + s*v.Z, v.Y, c*v.Z - s*v.X}
+}
+
+// rotates the vector by angle about the y axis
+func (v *Vector[T]) RotateY(angle T) *Vector[T] {
+	*v = RotateY(*v, angle)
+	return v
+}
+
+// rotates v by angle about the z axis, keeping Z
+func RotateZ[T Float](v Vector[T], angle T) Vector[T] {
+	c := T(math.Cos(-float64(angle)))
+	s := T(math.Sin(-float64(angle)))
+	return Vector[T]{c*v.X - s*v.Y, s*v.X + c*v.Y, v.Z}
+}
+
+// rotates the vector by angle about the z axis
+func (v *Vector[T]) RotateZ(angle T) *Vector[T] {
+	*v = RotateZ(*v, angle)
+	return v
+}
+
+// linear interpolation from v1 (t = 0) to v2 (t = 1)
+func Lerp[T Float](v1, v2 Vector[T], t T) Vector[T] {
+	return Vector[T]{
+		v1.X + (v2.X-v1.X)*t,
+		v1.Y + (v2.Y-v1.Y)*t,
+		v1.Z + (v2.Z-v1.Z)*t,
+	}
+}
+
+// moves this vector t of the way towards other
+func (v *Vector[T]) Lerp(other Vector[T], t T) *Vector[T] {
+	*v = Lerp(*v, other, t)
+	return v
+}
+
+// spherical interpolation from v1 (t = 0) to v2 (t = 1). See vector.Slerp
+func Slerp[T Float](v1, v2 Vector[T], t T) Vector[T] {
+	return From[T](vector.Slerp(v1.Vector(), v2.Vector(), float64(t)))
+}
+
+// turns this vector t of the way towards other
+func (v *Vector[T]) Slerp(other Vector[T], t T) *Vector[T] {
+	*v = Slerp(*v, other, t)
+	return v
+}
+
+// moves current towards target by at most maxDelta, stopping at target rather than overshooting
+func MoveTowards[T Float](current, target Vector[T], maxDelta T) Vector[T] {
+	d := Sub(target, current)
+	dist := d.Mag()
+	if dist <= maxDelta || dist == 0 {
+		return target
+	}
+	return Add(current, Mult(d, maxDelta/dist))
+}
+
+// moves this vector towards target by at most maxDelta
+func (v *Vector[T]) MoveTowards(target Vector[T], maxDelta T) *Vector[T] {
+	*v = MoveTowards(*v, target, maxDelta)
+	return v
+}
+
+// turns the 2d vector current towards the heading of target by at most
+// maxRadians, without changing its magnitude. See vector.RotateTowards
+func RotateTowards[T Float](current, target Vector[T], maxRadians T) Vector[T] {
+	return From[T](vector.RotateTowards(current.Vector(), target.Vector(), float64(maxRadians)))
+}
+
+// turns this vector towards the heading of target by at most maxRadians
+func (v *Vector[T]) RotateTowards(target Vector[T], maxRadians T) *Vector[T] {
+	*v = RotateTowards(*v, target, maxRadians)
+	return v
+}
+
+// moves current towards target like a critically damped spring. See vector.SmoothDamp
+func SmoothDamp[T Float](current, target Vector[T], velocity *Vector[T], smoothTime, dt T) Vector[T] {
+	vel := velocity.Vector()
+	out := vector.SmoothDamp(current.Vector(), target.Vector(), &vel, float64(smoothTime), float64(dt))
+	*velocity = From[T](vel)
+	return From[T](out)
+}
+
+// moves this vector towards target with SmoothDamp
+func (v *Vector[T]) SmoothDamp(target Vector[T], velocity *Vector[T], smoothTime, dt T) *Vector[T] {
+	*v = SmoothDamp(*v, target, velocity, smoothTime, dt)
+	return v
+}
+
+// creates a vector of length l in the direction angle
+//
+// FromAngle(angle) creates a unit vector, FromAngle(angle, length) one of that length
+func FromAngle[T Float](angle T, length ...T) Vector[T] {
+	l := 1.0
+	if len(length) > 0 {
+		l = float64(length[0])
+	}
+
+	a := -float64(angle)
+	return Vector[T]{T(l * math.Cos(a)), T(l * math.Sin(a)), 0}
+}
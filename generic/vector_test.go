@@ -0,0 +1,93 @@
+package generic
+
+import (
+	"math"
+	"testing"
+
+	vector "github.com/bawgafr/vector"
+)
+
+func TestFloat32(t *testing.T) {
+	t.Run("basic arithmetic", func(t *testing.T) {
+		v := NewVector[float32](1, 2)
+		v.Add(NewVector[float32](2, 2)).Mult(2)
+
+		if !v.Equals(Vector32{6, 8, 0}) {
+			t.Errorf("should be {6, 8, 0} not %v", v)
+		}
+		if v.Mag() != 10 {
+			t.Errorf("magnitude should be 10 not %f", v.Mag())
+		}
+	})
+
+	t.Run("limit and normalise", func(t *testing.T) {
+		v := Vector32{30, 40, 0}
+		v.Limit(5)
+
+		if !v.Equals(Vector32{3, 4, 0}) {
+			t.Errorf("should be {3, 4, 0} not %v", v)
+		}
+		if n := Normalise(v); !Equals(n, Vector32{0.6, 0.8, 0}) {
+			t.Errorf("should be {0.6, 0.8, 0} not %v", n)
+		}
+	})
+
+	t.Run("predicates", func(t *testing.T) {
+		v := Vector32{0.6, 0.8, 0}
+
+		if !v.IsUnit() || v.IsZero() {
+			t.Errorf("%v should be a unit vector", v)
+		}
+		if !IsParallel(v, Vector32{-3, -4, 0}) || !IsPerpendicular(v, Vector32{-4, 3, 0}) {
+			t.Error("should be parallel to (-3, -4) and perpendicular to (-4, 3)")
+		}
+	})
+
+	t.Run("angles", func(t *testing.T) {
+		v := FromAngle[float32](math.Pi/2, 2)
+
+		if !v.Equals(Vector32{0, -2, 0}) {
+			t.Errorf("should be {0, -2, 0} not %v", v)
+		}
+		if h := v.Heading(); abs(h+math.Pi/2) > 1e-6 {
+			t.Errorf("heading should be -π/2 not %f", h)
+		}
+		if r := Rotate(v, -math.Pi/2); !r.Equals(Vector32{2, 0, 0}) {
+			t.Errorf("should rotate back to {2, 0, 0} not %v", r)
+		}
+	})
+}
+
+func TestMatchesRootPackage(t *testing.T) {
+	a := vector.NewVector(1.5, -2, 3)
+	b := vector.NewVector(-4, 0.5, 2)
+
+	ga := From[float64](a)
+	gb := From[float64](b)
+
+	if c := Cross(ga, gb).Vector(); !c.Equals(vector.Cross(a, b)) {
+		t.Errorf("cross should be %v not %v", vector.Cross(a, b), c)
+	}
+	gc := From[float64](vector.NewVector(2, 1, -1))
+	if tp := TripleProductScalar(ga, gb, gc); tp != vector.TripleProductScalar(a, b, gc.Vector()) {
+		t.Errorf("triple product should be %f not %f", vector.TripleProductScalar(a, b, gc.Vector()), tp)
+	}
+	if d := Dist(ga, gb); d != vector.Dist(a, b) {
+		t.Errorf("dist should be %f not %f", vector.Dist(a, b), d)
+	}
+	if r := Reflect(ga, gb).Vector(); !r.Equals(vector.Reflect(a, b)) {
+		t.Errorf("reflect should be %v not %v", vector.Reflect(a, b), r)
+	}
+	if s := Slerp(ga, gb, 0.3).Vector(); !s.Equals(vector.Slerp(a, b, 0.3)) {
+		t.Errorf("slerp should be %v not %v", vector.Slerp(a, b, 0.3), s)
+	}
+	if r := RotateAxis(ga, gb, 0.7).Vector(); !r.Equals(vector.RotateAxis(a, b, 0.7)) {
+		t.Errorf("rotate axis should be %v not %v", vector.RotateAxis(a, b, 0.7), r)
+	}
+	if sa := SignedAngle2D(ga, gb); sa != vector.SignedAngle2D(a, b) {
+		t.Errorf("signed angle should be %f not %f", vector.SignedAngle2D(a, b), sa)
+	}
+	if r := RotateY(ga, 0.7).Vector(); !r.Equals(vector.RotateY(a, 0.7)) {
+		t.Errorf("rotate y should be %v not %v", vector.RotateY(a, 0.7), r)
+	}
+}
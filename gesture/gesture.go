@@ -0,0 +1,184 @@
+// Package gesture is the $1 unistroke recogniser (Wobbrock, Wilson and Li 2007)
+//
+// Strokes are resampled to a fixed number of points, rotated so the angle
+// from their centre to their first point is zero, scaled to a square and
+// moved to the origin, then compared point by point against templates.
+package gesture
+
+import (
+	"math"
+
+	vector "github.com/bawgafr/vector"
+)
+
+const (
+	// how many points each stroke is resampled to
+	NumPoints = 64
+	// the side of the square strokes are scaled to
+	SquareSize = 250.0
+
+	angleRange     = 45 * math.Pi / 180
+	anglePrecision = 2 * math.Pi / 180
+)
+
+// 0.5(-1 + √5), used by the golden section search
+var phi = 0.5 * (-1 + math.Sqrt(5))
+
+// a named, normalised stroke to match against
+type Template struct {
+	Name   string
+	Points []vector.Vector
+}
+
+// matches strokes against a set of templates
+type Recogniser struct {
+	Templates []Template
+}
+
+// creates an empty Recogniser
+func NewRecogniser() *Recogniser {
+	return &Recogniser{}
+}
+
+// normalises the stroke and adds it as a template called name
+func (r *Recogniser) AddTemplate(name string, points []vector.Vector) {
+	r.Templates = append(r.Templates, Template{name, Normalise(points)})
+}
+
+// finds the template closest to the stroke
+//
+// score runs from 1 for a perfect match down towards 0. With no templates, or
+// a stroke with fewer than 2 points, it returns "" and 0
+func (r *Recogniser) Recognise(points []vector.Vector) (name string, score float64) {
+	if len(r.Templates) == 0 || len(points) < 2 {
+		return "", 0
+	}
+
+	candidate := Normalise(points)
+
+	best := math.Inf(1)
+	for _, t := range r.Templates {
+		d := distanceAtBestAngle(candidate, t.Points, -angleRange, angleRange, anglePrecision)
+		if d < best {
+			best = d
+			name = t.Name
+		}
+	}
+
+	halfDiagonal := 0.5 * math.Sqrt(2*SquareSize*SquareSize)
+	return name, 1 - best/halfDiagonal
+}
+
+// runs all the $1 normalisation steps on the stroke
+func Normalise(points []vector.Vector) []vector.Vector {
+	ps := Resample(points, NumPoints)
+	ps = RotateBy(ps, -IndicativeAngle(ps))
+	ps = ScaleTo(ps, SquareSize)
+	return TranslateTo(ps, vector.Vector{})
+}
+
+// n points evenly spaced along the stroke
+func Resample(points []vector.Vector, n int) []vector.Vector {
+	return vector.ResampleOutline(points, false, n)
+}
+
+// the heading from the centre of the stroke to its first point
+func IndicativeAngle(points []vector.Vector) float64 {
+	return vector.Direction(vector.Sub(points[0], centroid(points))).Radians()
+}
+
+// rotates the stroke about its centre by angle (the same direction as vector.Rotate)
+func RotateBy(points []vector.Vector, angle float64) []vector.Vector {
+	c := centroid(points)
+	out := make([]vector.Vector, len(points))
+	for i, p := range points {
+		out[i] = vector.Add(vector.Rotate(vector.Sub(p, c), angle), c)
+	}
+	return out
+}
+
+// scales the stroke, without keeping its aspect ratio, to fit a size x size square
+func ScaleTo(points []vector.Vector, size float64) []vector.Vector {
+	lo, hi := bounds(points)
+	w := hi.X - lo.X
+	h := hi.Y - lo.Y
+
+	out := make([]vector.Vector, len(points))
+	for i, p := range points {
+		out[i] = vector.NewVector(scaleAxis(p.X, w, size), scaleAxis(p.Y, h, size))
+	}
+	return out
+}
+
+// a line has no height so leave that axis alone rather than divide by zero
+func scaleAxis(x, extent, size float64) float64 {
+	if extent == 0 {
+		return x
+	}
+	return x * size / extent
+}
+
+// moves the stroke so its centre is at p
+func TranslateTo(points []vector.Vector, p vector.Vector) []vector.Vector {
+	d := vector.Sub(p, centroid(points))
+	out := make([]vector.Vector, len(points))
+	for i, q := range points {
+		out[i] = vector.Add(q, d)
+	}
+	return out
+}
+
+// golden section search for the rotation of a that best matches b
+func distanceAtBestAngle(a, b []vector.Vector, from, to, threshold float64) float64 {
+	x1 := phi*from + (1-phi)*to
+	f1 := distanceAtAngle(a, b, x1)
+	x2 := (1-phi)*from + phi*to
+	f2 := distanceAtAngle(a, b, x2)
+
+	for math.Abs(to-from) > threshold {
+		if f1 < f2 {
+			to = x2
+			x2, f2 = x1, f1
+			x1 = phi*from + (1-phi)*to
+			f1 = distanceAtAngle(a, b, x1)
+		} else {
+			from = x1
+			x1, f1 = x2, f2
+			x2 = (1-phi)*from + phi*to
+			f2 = distanceAtAngle(a, b, x2)
+		}
+	}
+
+	return min(f1, f2)
+}
+
+func distanceAtAngle(a, b []vector.Vector, angle float64) float64 {
+	return pathDistance(RotateBy(a, angle), b)
+}
+
+// the average distance between matching points
+func pathDistance(a, b []vector.Vector) float64 {
+	n := min(len(a), len(b))
+	d := 0.0
+	for i := 0; i < n; i++ {
+		d += vector.Dist(a[i], b[i])
+	}
+	return d / float64(n)
+}
+
+func centroid(points []vector.Vector) vector.Vector {
+	var c vector.Vector
+	for _, p := range points {
+		c.Add(p)
+	}
+	return vector.Div(c, float64(len(points)))
+}
+
+func bounds(points []vector.Vector) (lo, hi vector.Vector) {
+	lo, hi = points[0], points[0]
+	for _, p := range points {
+		lo = vector.NewVector(min(lo.X, p.X), min(lo.Y, p.Y))
+		hi = vector.NewVector(max(hi.X, p.X), max(hi.Y, p.Y))
+	}
+	return
+}
@@ -0,0 +1,76 @@
+package gesture
+
+import (
+	"math"
+	"testing"
+
+	vector "github.com/bawgafr/vector"
+)
+
+func triangle() []vector.Vector {
+	return []vector.Vector{
+		vector.NewVector(0, 100),
+		vector.NewVector(50, 0),
+		vector.NewVector(100, 100),
+		vector.NewVector(0, 100),
+	}
+}
+
+func check() []vector.Vector {
+	return []vector.Vector{
+		vector.NewVector(0, 50),
+		vector.NewVector(30, 90),
+		vector.NewVector(100, 0),
+	}
+}
+
+func circle() []vector.Vector {
+	return vector.ArcPoints(vector.NewVector(50, 50), 50, 0, 2*math.Pi, 40)
+}
+
+func TestResample(t *testing.T) {
+	ps := Resample([]vector.Vector{vector.NewVector(0, 0), vector.NewVector(63, 0)}, NumPoints)
+
+	if len(ps) != NumPoints {
+		t.Fatalf("should have %d points not %d", NumPoints, len(ps))
+	}
+	for i, p := range ps {
+		if !p.Equals(vector.NewVector(float64(i), 0)) {
+			t.Errorf("point %d should be {%d, 0} not %v", i, i, p)
+		}
+	}
+}
+
+func TestRecognise(t *testing.T) {
+	r := NewRecogniser()
+	r.AddTemplate("triangle", triangle())
+	r.AddTemplate("check", check())
+	r.AddTemplate("circle", circle())
+
+	t.Run("rotated, scaled and moved strokes still match", func(t *testing.T) {
+		for name, stroke := range map[string][]vector.Vector{
+			"triangle": triangle(),
+			"check":    check(),
+			"circle":   circle(),
+		} {
+			moved := make([]vector.Vector, len(stroke))
+			for i, p := range stroke {
+				moved[i] = vector.Add(vector.Mult(vector.Rotate(p, 0.3), 0.4), vector.NewVector(500, 20))
+			}
+
+			got, score := r.Recognise(moved)
+			if got != name {
+				t.Errorf("%s recognised as %s (%f)", name, got, score)
+			}
+			if score < 0.8 {
+				t.Errorf("%s score too low %f", name, score)
+			}
+		}
+	})
+
+	t.Run("nothing to match", func(t *testing.T) {
+		if name, _ := NewRecogniser().Recognise(check()); name != "" {
+			t.Errorf("should not match %s", name)
+		}
+	})
+}
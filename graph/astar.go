@@ -0,0 +1,94 @@
+// Package graph has path finding over graphs whose nodes are Vectors
+package graph
+
+import (
+	"container/heap"
+
+	vector "github.com/bawgafr/vector"
+)
+
+// finds the shortest path from start to goal with A*
+//
+// edges[i] lists the nodes reachable from node i and each edge costs the
+// distance between its nodes. heuristic estimates the remaining distance and
+// should never overestimate it; nil uses vector.Dist. Returns the node indices
+// from start to goal, or nil if goal can't be reached
+func AStar(nodes []vector.Vector, edges [][]int, start, goal int, heuristic func(a, b vector.Vector) float64) []int {
+	if heuristic == nil {
+		heuristic = vector.Dist
+	}
+	if start < 0 || goal < 0 || start >= len(nodes) || goal >= len(nodes) {
+		return nil
+	}
+
+	cost := map[int]float64{start: 0}
+	from := map[int]int{}
+	closed := map[int]bool{}
+
+	open := &queue{{node: start, priority: heuristic(nodes[start], nodes[goal])}}
+
+	for open.Len() > 0 {
+		current := heap.Pop(open).(item).node
+		if current == goal {
+			return walkBack(from, start, goal)
+		}
+		if closed[current] {
+			continue
+		}
+		closed[current] = true
+
+		if current >= len(edges) {
+			continue
+		}
+
+		for _, next := range edges[current] {
+			if closed[next] {
+				continue
+			}
+
+			c := cost[current] + vector.Dist(nodes[current], nodes[next])
+			if old, seen := cost[next]; seen && c >= old {
+				continue
+			}
+
+			cost[next] = c
+			from[next] = current
+			heap.Push(open, item{node: next, priority: c + heuristic(nodes[next], nodes[goal])})
+		}
+	}
+
+	return nil
+}
+
+func walkBack(from map[int]int, start, goal int) []int {
+	path := []int{goal}
+	for n := goal; n != start; {
+		n = from[n]
+		path = append(path, n)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+type item struct {
+	node     int
+	priority float64
+}
+
+// a min heap of items ordered by priority
+type queue []item
+
+func (q queue) Len() int           { return len(q) }
+func (q queue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q queue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x any)        { *q = append(*q, x.(item)) }
+func (q *queue) Pop() any {
+	old := *q
+	n := len(old)
+	it := old[n-1]
+	*q = old[:n-1]
+	return it
+}
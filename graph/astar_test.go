@@ -0,0 +1,54 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+
+	vector "github.com/bawgafr/vector"
+)
+
+func TestAStar(t *testing.T) {
+	//  0 --- 1 --- 2
+	//  |           |
+	//  3 --------- 4
+	nodes := []vector.Vector{
+		vector.NewVector(0, 0),
+		vector.NewVector(5, 3),
+		vector.NewVector(10, 0),
+		vector.NewVector(0, 1),
+		vector.NewVector(10, 1),
+	}
+	edges := [][]int{
+		{1, 3},
+		{0, 2},
+		{1, 4},
+		{0, 4},
+		{2, 3},
+	}
+
+	t.Run("finds the shortest path", func(t *testing.T) {
+		path := AStar(nodes, edges, 0, 2, nil)
+		expected := []int{0, 1, 2}
+
+		if !slices.Equal(path, expected) {
+			t.Errorf("path should be %v not %v", expected, path)
+		}
+	})
+
+	t.Run("takes the long way round when it is shorter", func(t *testing.T) {
+		path := AStar(nodes, edges, 3, 2, nil)
+		expected := []int{3, 4, 2}
+
+		if !slices.Equal(path, expected) {
+			t.Errorf("path should be %v not %v", expected, path)
+		}
+	})
+
+	t.Run("unreachable goal", func(t *testing.T) {
+		path := AStar(append(nodes, vector.NewVector(50, 50)), edges, 0, 5, nil)
+
+		if path != nil {
+			t.Errorf("should not find a path %v", path)
+		}
+	})
+}
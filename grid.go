@@ -0,0 +1,178 @@
+package vector
+
+import "math"
+
+// a 2d grid storing a Vector at each node, eg a wind or flow field
+//
+// node (i, j) sits at Origin + (i*CellSize, j*CellSize). i is the column, j the row
+type Grid2D struct {
+	Cols, Rows int
+	CellSize   float64
+	Origin     Vector
+	cells      []Vector
+}
+
+// a 2d grid storing a float64 at each node, laid out the same way as Grid2D
+type ScalarGrid2D struct {
+	Cols, Rows int
+	CellSize   float64
+	Origin     Vector
+	cells      []float64
+}
+
+// creates a grid of zero vectors. Negative sizes give an empty grid
+func NewGrid2D(cols, rows int, cellSize float64) *Grid2D {
+	cols, rows = max(0, cols), max(0, rows)
+	return &Grid2D{Cols: cols, Rows: rows, CellSize: cellSize, cells: make([]Vector, cols*rows)}
+}
+
+// creates a grid of zeros. Negative sizes give an empty grid
+func NewScalarGrid2D(cols, rows int, cellSize float64) *ScalarGrid2D {
+	cols, rows = max(0, cols), max(0, rows)
+	return &ScalarGrid2D{Cols: cols, Rows: rows, CellSize: cellSize, cells: make([]float64, cols*rows)}
+}
+
+// clamps i, j to the grid and returns the index into cells
+func gridIndex(cols, rows, i, j int) int {
+	i = max(0, min(i, cols-1))
+	j = max(0, min(j, rows-1))
+	return j*cols + i
+}
+
+// returns the vector at node (i, j). Indices outside the grid are clamped to
+// the edge, and an empty grid is zero everywhere
+func (g *Grid2D) At(i, j int) Vector {
+	if len(g.cells) == 0 {
+		return Vector{}
+	}
+	return g.cells[gridIndex(g.Cols, g.Rows, i, j)]
+}
+
+// sets the vector at node (i, j). Indices outside the grid are ignored
+func (g *Grid2D) Set(i, j int, v Vector) {
+	if i < 0 || j < 0 || i >= g.Cols || j >= g.Rows {
+		return
+	}
+	g.cells[j*g.Cols+i] = v
+}
+
+// returns the world position of node (i, j)
+func (g *Grid2D) Pos(i, j int) Vector {
+	return NewVector(g.Origin.X+float64(i)*g.CellSize, g.Origin.Y+float64(j)*g.CellSize)
+}
+
+// bilinearly interpolates the field at the world position p
+func (g *Grid2D) SampleBilinear(p Vector) Vector {
+	i, j, fx, fy := bilinearCoords(g.Origin, g.CellSize, p)
+
+	a := Lerp(g.At(i, j), g.At(i+1, j), fx)
+	b := Lerp(g.At(i, j+1), g.At(i+1, j+1), fx)
+	return Lerp(a, b, fy)
+}
+
+// the divergence (dFx/dx + dFy/dy) of the field at every node
+func (g *Grid2D) Divergence() *ScalarGrid2D {
+	out := &ScalarGrid2D{Cols: g.Cols, Rows: g.Rows, CellSize: g.CellSize, Origin: g.Origin, cells: make([]float64, len(g.cells))}
+
+	for j := 0; j < g.Rows; j++ {
+		for i := 0; i < g.Cols; i++ {
+			dx := (g.At(i+1, j).X - g.At(i-1, j).X) / g.spacing(i, g.Cols)
+			dy := (g.At(i, j+1).Y - g.At(i, j-1).Y) / g.spacing(j, g.Rows)
+			out.cells[j*g.Cols+i] = dx + dy
+		}
+	}
+
+	return out
+}
+
+// the z component of the curl (dFy/dx - dFx/dy) of the field at every node
+func (g *Grid2D) Curl2D() *ScalarGrid2D {
+	out := &ScalarGrid2D{Cols: g.Cols, Rows: g.Rows, CellSize: g.CellSize, Origin: g.Origin, cells: make([]float64, len(g.cells))}
+
+	for j := 0; j < g.Rows; j++ {
+		for i := 0; i < g.Cols; i++ {
+			dFydx := (g.At(i+1, j).Y - g.At(i-1, j).Y) / g.spacing(i, g.Cols)
+			dFxdy := (g.At(i, j+1).X - g.At(i, j-1).X) / g.spacing(j, g.Rows)
+			out.cells[j*g.Cols+i] = dFydx - dFxdy
+		}
+	}
+
+	return out
+}
+
+// distance between the neighbours used for a central difference. At the edges
+// the clamped neighbour is the node itself so the difference is one sided
+func (g *Grid2D) spacing(i, n int) float64 {
+	return differenceSpacing(i, n, g.CellSize)
+}
+
+func differenceSpacing(i, n int, cellSize float64) float64 {
+	steps := 2
+	if i == 0 {
+		steps--
+	}
+	if i == n-1 {
+		steps--
+	}
+	if steps == 0 {
+		return math.Inf(1)
+	}
+	return float64(steps) * cellSize
+}
+
+// returns the value at node (i, j). Indices outside the grid are clamped to
+// the edge, and an empty grid is zero everywhere
+func (s *ScalarGrid2D) At(i, j int) float64 {
+	if len(s.cells) == 0 {
+		return 0
+	}
+	return s.cells[gridIndex(s.Cols, s.Rows, i, j)]
+}
+
+// sets the value at node (i, j). Indices outside the grid are ignored
+func (s *ScalarGrid2D) Set(i, j int, v float64) {
+	if i < 0 || j < 0 || i >= s.Cols || j >= s.Rows {
+		return
+	}
+	s.cells[j*s.Cols+i] = v
+}
+
+// bilinearly interpolates the value at the world position p
+func (s *ScalarGrid2D) SampleBilinear(p Vector) float64 {
+	i, j, fx, fy := bilinearCoords(s.Origin, s.CellSize, p)
+
+	a := s.At(i, j) + (s.At(i+1, j)-s.At(i, j))*fx
+	b := s.At(i, j+1) + (s.At(i+1, j+1)-s.At(i, j+1))*fx
+	return a + (b-a)*fy
+}
+
+// the gradient of the scalar field at every node
+func (s *ScalarGrid2D) Gradient() *Grid2D {
+	out := &Grid2D{Cols: s.Cols, Rows: s.Rows, CellSize: s.CellSize, Origin: s.Origin, cells: make([]Vector, len(s.cells))}
+
+	for j := 0; j < s.Rows; j++ {
+		for i := 0; i < s.Cols; i++ {
+			dx := (s.At(i+1, j) - s.At(i-1, j)) / differenceSpacing(i, s.Cols, s.CellSize)
+			dy := (s.At(i, j+1) - s.At(i, j-1)) / differenceSpacing(j, s.Rows, s.CellSize)
+			out.cells[j*s.Cols+i] = NewVector(dx, dy)
+		}
+	}
+
+	return out
+}
+
+// the gradient of a scalar grid
+func Gradient(s *ScalarGrid2D) *Grid2D {
+	return s.Gradient()
+}
+
+// splits a world position into the node to its top left and the fractional offset from it
+func bilinearCoords(origin Vector, cellSize float64, p Vector) (i, j int, fx, fy float64) {
+	gx := (p.X - origin.X) / cellSize
+	gy := (p.Y - origin.Y) / cellSize
+
+	fi := math.Floor(gx)
+	fj := math.Floor(gy)
+
+	return int(fi), int(fj), gx - fi, gy - fj
+}
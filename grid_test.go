@@ -0,0 +1,93 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGrid2D(t *testing.T) {
+	t.Run("sample bilinear between nodes", func(t *testing.T) {
+		g := NewGrid2D(2, 2, 10)
+		g.Set(0, 0, NewVector(0, 0))
+		g.Set(1, 0, NewVector(10, 0))
+		g.Set(0, 1, NewVector(0, 10))
+		g.Set(1, 1, NewVector(10, 10))
+
+		v := g.SampleBilinear(NewVector(5, 2.5))
+		if !v.Equals(NewVector(5, 2.5)) {
+			t.Errorf("sampled %v (expected {5, 2.5})", v)
+		}
+	})
+
+	t.Run("empty grids", func(t *testing.T) {
+		g := NewGrid2D(0, 3, 1)
+		s := NewScalarGrid2D(-2, -1, 1)
+		g.Set(0, 0, NewVector(1, 1))
+		s.Set(0, 0, 1)
+
+		if v := g.At(0, 0); !v.Equals(Vector{}) {
+			t.Errorf("should be zero not %v", v)
+		}
+		if v := g.SampleBilinear(NewVector(0.5, 0.5)); !v.Equals(Vector{}) {
+			t.Errorf("should sample zero not %v", v)
+		}
+		if v := s.At(1, 1); v != 0 || s.Cols != 0 || s.Rows != 0 {
+			t.Errorf("should be an empty grid of zeros not %dx%d with %f", s.Cols, s.Rows, v)
+		}
+		if d := g.Divergence(); d.At(0, 0) != 0 {
+			t.Errorf("divergence should be zero not %f", d.At(0, 0))
+		}
+	})
+
+	t.Run("divergence of a radial field", func(t *testing.T) {
+		g := NewGrid2D(5, 5, 1)
+		for j := 0; j < 5; j++ {
+			for i := 0; i < 5; i++ {
+				g.Set(i, j, g.Pos(i, j))
+			}
+		}
+
+		d := g.Divergence().At(2, 2)
+		if !compare(t, d, 2) {
+			t.Errorf("divergence of F(x,y)=(x,y) should be 2 not %f", d)
+		}
+
+		c := g.Curl2D().At(2, 2)
+		if !compare(t, c, 0) {
+			t.Errorf("curl of F(x,y)=(x,y) should be 0 not %f", c)
+		}
+	})
+
+	t.Run("curl of a rotational field", func(t *testing.T) {
+		g := NewGrid2D(5, 5, 1)
+		for j := 0; j < 5; j++ {
+			for i := 0; i < 5; i++ {
+				p := g.Pos(i, j)
+				g.Set(i, j, NewVector(-p.Y, p.X))
+			}
+		}
+
+		c := g.Curl2D().At(1, 3)
+		if !compare(t, c, 2) {
+			t.Errorf("curl of F(x,y)=(-y,x) should be 2 not %f", c)
+		}
+	})
+
+	t.Run("gradient of a scalar grid", func(t *testing.T) {
+		s := NewScalarGrid2D(4, 4, 0.5)
+		for j := 0; j < 4; j++ {
+			for i := 0; i < 4; i++ {
+				x := float64(i) * 0.5
+				y := float64(j) * 0.5
+				s.Set(i, j, 3*x+math.Pi*y)
+			}
+		}
+
+		for _, ij := range [][2]int{{0, 0}, {1, 2}, {3, 3}} {
+			g := Gradient(s).At(ij[0], ij[1])
+			if !g.Equals(NewVector(3, math.Pi)) {
+				t.Errorf("gradient at %v should be {3, π} not %v", ij, g)
+			}
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package vector
+
+// moves something from cell to cell on a grid, giving smooth positions in between
+//
+// Speed is in cells per second. Ease shapes each move, taking the fraction of
+// the move done (0 to 1) and returning how far along to draw it; nil means
+// linear. A new target given mid move starts from where it's drawn now
+type GridMover struct {
+	CellSize float64
+	Origin   Vector
+	Speed    float64
+	Ease     func(t float64) float64
+
+	from, to Vector
+	cell     IVec
+	progress float64
+	length   float64
+}
+
+// creates a GridMover sitting in cell
+func NewGridMover(cell IVec, cellSize, speed float64) *GridMover {
+	g := &GridMover{CellSize: cellSize, Speed: speed, cell: cell}
+	g.from = cell.Vector()
+	g.to = g.from
+	g.progress = 1
+	return g
+}
+
+// starts moving towards cell
+func (g *GridMover) MoveTo(cell IVec) {
+	g.from = g.current()
+	g.to = cell.Vector()
+	g.cell = cell
+	g.length = Dist(g.from, g.to)
+	g.progress = 0
+	if g.length == 0 {
+		g.progress = 1
+	}
+}
+
+// advances the move by dt seconds and returns the new position
+func (g *GridMover) Update(dt float64) Vector {
+	if g.progress < 1 && g.Speed > 0 {
+		g.progress = min(1, g.progress+g.Speed*dt/g.length)
+	}
+	return g.Position()
+}
+
+// the position in world units, at the middle of the cell
+func (g *GridMover) Position() Vector {
+	return Add(Mult(Add(g.current(), NewVector(0.5, 0.5)), g.CellSize), g.Origin)
+}
+
+// the cell being moved to, or sat in
+func (g *GridMover) Cell() IVec {
+	return g.cell
+}
+
+// check if a move is under way
+func (g *GridMover) Moving() bool {
+	return g.progress < 1
+}
+
+// the position in cells
+func (g *GridMover) current() Vector {
+	t := g.progress
+	if g.Ease != nil {
+		t = g.Ease(t)
+	}
+	return Lerp(g.from, g.to, t)
+}
@@ -0,0 +1,53 @@
+package vector
+
+import "testing"
+
+func TestGridMover(t *testing.T) {
+	t.Run("moves a cell at speed", func(t *testing.T) {
+		g := NewGridMover(IVec{0, 0}, 10, 2)
+		if p := g.Position(); !p.Equals(NewVector(5, 5)) {
+			t.Errorf("should start in the middle of the cell not %v", p)
+		}
+
+		g.MoveTo(IVec{1, 0})
+		if p := g.Update(0.25); !p.Equals(NewVector(10, 5)) {
+			t.Errorf("should be half way at (10, 5) not %v", p)
+		}
+		if !g.Moving() {
+			t.Error("should still be moving")
+		}
+		if p := g.Update(1); !p.Equals(NewVector(15, 5)) || g.Moving() {
+			t.Errorf("should have arrived at (15, 5) not %v", p)
+		}
+	})
+
+	t.Run("easing", func(t *testing.T) {
+		g := NewGridMover(IVec{0, 0}, 1, 1)
+		g.Ease = func(t float64) float64 { return t * t }
+		g.MoveTo(IVec{0, 2})
+
+		if p := g.Update(1); !p.Equals(NewVector(0.5, 1)) {
+			t.Errorf("a quarter of the way should be (0.5, 1) not %v", p)
+		}
+	})
+
+	t.Run("retarget mid move", func(t *testing.T) {
+		g := NewGridMover(IVec{0, 0}, 1, 1)
+		g.MoveTo(IVec{2, 0})
+		before := g.Update(1)
+		g.MoveTo(IVec{1, 1})
+
+		if p := g.Position(); !p.Equals(before) {
+			t.Errorf("shouldn't jump when retargeted, %v to %v", before, p)
+		}
+		if g.Cell() != (IVec{1, 1}) {
+			t.Errorf("cell should be (1, 1) not %v", g.Cell())
+		}
+		for range 10 {
+			g.Update(0.5)
+		}
+		if p := g.Position(); !p.Equals(NewVector(1.5, 1.5)) {
+			t.Errorf("should end at (1.5, 1.5) not %v", p)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package vector
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+)
+
+// the grid HashState snaps components to before hashing
+const HashResolution = 1e-6
+
+// a 64 bit hash of vs, so two runs of a simulation can check they haven't
+// diverged by swapping hashes rather than whole states
+//
+// Each component is rounded to the nearest multiple of HashResolution and
+// written as a little endian int64, so the hash doesn't depend on the
+// platform and ignores noise below the resolution. -0 hashes the same as 0
+// and every NaN the same as every other. Values that land either side of a
+// rounding boundary still hash differently, so compare the exact states
+// when hashes don't match. The order of vs matters
+func HashState(vs []Vector) uint64 {
+	h := fnv.New64a()
+	var buf [24]byte
+	for _, v := range vs {
+		binary.LittleEndian.PutUint64(buf[0:], uint64(quantiseComponent(v.X)))
+		binary.LittleEndian.PutUint64(buf[8:], uint64(quantiseComponent(v.Y)))
+		binary.LittleEndian.PutUint64(buf[16:], uint64(quantiseComponent(v.Z)))
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+// a single component rounded to HashResolution
+func quantiseComponent(c float64) int64 {
+	switch {
+	case math.IsNaN(c):
+		return math.MinInt64
+	case c >= math.MaxInt64*HashResolution:
+		return math.MaxInt64
+	case c <= math.MinInt64*HashResolution:
+		return math.MinInt64 + 1
+	}
+	return int64(math.Round(c / HashResolution))
+}
@@ -0,0 +1,48 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHashState(t *testing.T) {
+	state := []Vector{NewVector(1, 2, 3), NewVector(-4.5, 0.25), NewVector(1e6, -1e-3, 7)}
+
+	t.Run("same state same hash", func(t *testing.T) {
+		again := append([]Vector{}, state...)
+		again[1].X += 1e-9
+
+		if HashState(state) != HashState(again) {
+			t.Error("noise below the resolution should be ignored")
+		}
+		if HashState([]Vector{NewVector(0, 0)}) != HashState([]Vector{NewVector(math.Copysign(0, -1), 0)}) {
+			t.Error("-0 should hash the same as 0")
+		}
+		if HashState([]Vector{NewVector(math.NaN())}) != HashState([]Vector{NewVector(-math.NaN())}) {
+			t.Error("NaNs should all hash the same")
+		}
+	})
+
+	t.Run("diverged", func(t *testing.T) {
+		moved := append([]Vector{}, state...)
+		moved[2].Z += 1e-4
+		swapped := []Vector{state[1], state[0], state[2]}
+
+		h := HashState(state)
+		if h == HashState(moved) {
+			t.Error("a moved vector should change the hash")
+		}
+		if h == HashState(swapped) {
+			t.Error("reordering should change the hash")
+		}
+		if h == HashState(state[:2]) {
+			t.Error("a missing vector should change the hash")
+		}
+	})
+
+	t.Run("huge values", func(t *testing.T) {
+		if HashState([]Vector{NewVector(math.Inf(1))}) == HashState([]Vector{NewVector(math.Inf(-1))}) {
+			t.Error("+Inf and -Inf should hash differently")
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package vector
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// a grid of cells that counts up how much time things spend in each, eg to
+// see where players or agents go
+//
+// Cell (i, j) covers Origin + (i*CellSize, j*CellSize) to
+// Origin + ((i+1)*CellSize, (j+1)*CellSize). i is the column, j the row
+type Heatmap struct {
+	Cols, Rows int
+	CellSize   float64
+	Origin     Vector
+	cells      []float64
+}
+
+// creates an empty heatmap
+func NewHeatmap(cols, rows int, cellSize float64) *Heatmap {
+	return &Heatmap{Cols: cols, Rows: rows, CellSize: cellSize, cells: make([]float64, cols*rows)}
+}
+
+// adds weight to the cell containing p. Points outside the heatmap are ignored
+func (h *Heatmap) Add(p Vector, weight float64) {
+	c := CellOf(Sub(p, h.Origin), h.CellSize)
+	if c.X < 0 || c.Y < 0 || c.X >= h.Cols || c.Y >= h.Rows {
+		return
+	}
+	h.cells[c.Y*h.Cols+c.X] += weight
+}
+
+// the total in cell (i, j), or 0 outside the heatmap
+func (h *Heatmap) At(i, j int) float64 {
+	if i < 0 || j < 0 || i >= h.Cols || j >= h.Rows {
+		return 0
+	}
+	return h.cells[j*h.Cols+i]
+}
+
+// fades every cell as dt passes, so that it halves every halfLife
+func (h *Heatmap) Decay(dt, halfLife float64) {
+	if halfLife <= 0 {
+		clear(h.cells)
+		return
+	}
+	f := math.Pow(0.5, dt/halfLife)
+	for i := range h.cells {
+		h.cells[i] *= f
+	}
+}
+
+// the largest total in any cell
+func (h *Heatmap) Max() float64 {
+	m := 0.0
+	for _, c := range h.cells {
+		m = max(m, c)
+	}
+	return m
+}
+
+// the totals scaled so the largest is 1, indexed [row][col]
+func (h *Heatmap) Normalised() [][]float64 {
+	m := h.Max()
+	out := make([][]float64, h.Rows)
+	for j := range out {
+		out[j] = make([]float64, h.Cols)
+		if m == 0 {
+			continue
+		}
+		for i := range out[j] {
+			out[j][i] = h.cells[j*h.Cols+i] / m
+		}
+	}
+	return out
+}
+
+// the heatmap as a greyscale image with one pixel per cell, white for the largest total
+func (h *Heatmap) Image() image.Image {
+	img := image.NewGray(image.Rect(0, 0, h.Cols, h.Rows))
+	for j, row := range h.Normalised() {
+		for i, v := range row {
+			img.SetGray(i, j, color.Gray{uint8(math.Round(max(0, v) * 255))})
+		}
+	}
+	return img
+}
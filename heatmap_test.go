@@ -0,0 +1,51 @@
+package vector
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestHeatmap(t *testing.T) {
+	h := NewHeatmap(4, 3, 10)
+	h.Origin = NewVector(100, 0)
+	h.Add(NewVector(105, 5), 1)
+	h.Add(NewVector(109, 1), 1)
+	h.Add(NewVector(125, 29), 0.5)
+	h.Add(NewVector(50, 5), 1)
+	h.Add(NewVector(145, 5), 1)
+
+	if h.At(0, 0) != 2 || h.At(2, 2) != 0.5 {
+		t.Errorf("cells should be 2 and 0.5 not %f and %f", h.At(0, 0), h.At(2, 2))
+	}
+
+	t.Run("normalised", func(t *testing.T) {
+		n := h.Normalised()
+
+		if len(n) != 3 || len(n[0]) != 4 {
+			t.Fatalf("should be 3 rows of 4 not %d rows", len(n))
+		}
+		if n[0][0] != 1 || n[2][2] != 0.25 {
+			t.Errorf("should be 1 and 0.25 not %f and %f", n[0][0], n[2][2])
+		}
+	})
+
+	t.Run("image", func(t *testing.T) {
+		img := h.Image()
+
+		if b := img.Bounds(); b.Dx() != 4 || b.Dy() != 3 {
+			t.Errorf("should be 4x3 not %v", b)
+		}
+		if c := color.GrayModel.Convert(img.At(0, 0)).(color.Gray); c.Y != 255 {
+			t.Errorf("the hottest cell should be white not %v", c)
+		}
+	})
+
+	t.Run("decay", func(t *testing.T) {
+		h.Decay(2, 1)
+
+		if math.Abs(h.At(0, 0)-0.5) > 1e-12 {
+			t.Errorf("two half lives should leave a quarter, got %f", h.At(0, 0))
+		}
+	})
+}
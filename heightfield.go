@@ -0,0 +1,33 @@
+package vector
+
+// the unit surface normal at every point of a heightfield, indexed [row][col]
+// like heights (eg from Heatmap.Normalised)
+//
+// Column i is at x = i*cellSize, row j at y = j*cellSize and the height is z,
+// so flat ground has the normal (0, 0, 1) and slopes lean it away from the
+// high side. Slopes come from central differences, one sided at the edges.
+// The rows are expected to all be the same length
+func HeightfieldNormals(heights [][]float64, cellSize float64) [][]Vector {
+	rows := len(heights)
+	normals := make([][]Vector, rows)
+	if rows == 0 {
+		return normals
+	}
+	cols := len(heights[0])
+
+	h := func(i, j int) float64 {
+		j = max(0, min(j, rows-1))
+		i = max(0, min(i, len(heights[j])-1))
+		return heights[j][i]
+	}
+
+	for j := range rows {
+		normals[j] = make([]Vector, cols)
+		for i := range cols {
+			dx := (h(i+1, j) - h(i-1, j)) / differenceSpacing(i, cols, cellSize)
+			dy := (h(i, j+1) - h(i, j-1)) / differenceSpacing(j, rows, cellSize)
+			normals[j][i] = Normalise(NewVector(-dx, -dy, 1))
+		}
+	}
+	return normals
+}
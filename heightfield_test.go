@@ -0,0 +1,60 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHeightfieldNormals(t *testing.T) {
+	t.Run("flat", func(t *testing.T) {
+		normals := HeightfieldNormals([][]float64{{2, 2, 2}, {2, 2, 2}}, 1)
+
+		if len(normals) != 2 || len(normals[0]) != 3 {
+			t.Fatalf("should be 2 rows of 3 not %v", normals)
+		}
+		for _, row := range normals {
+			for _, n := range row {
+				if !n.Equals(NewVector(0, 0, 1)) {
+					t.Errorf("should be (0, 0, 1) not %v", n)
+				}
+			}
+		}
+	})
+
+	t.Run("slope", func(t *testing.T) {
+		// rising 1 per cell of 2 along x, so every normal leans back towards -x
+		heights := [][]float64{{0, 1, 2, 3}, {0, 1, 2, 3}, {0, 1, 2, 3}}
+		want := Normalise(NewVector(-0.5, 0, 1))
+
+		for j, row := range HeightfieldNormals(heights, 2) {
+			for i, n := range row {
+				if !n.Equals(want) {
+					t.Errorf("(%d, %d) should be %v not %v", i, j, want, n)
+				}
+			}
+		}
+	})
+
+	t.Run("peak", func(t *testing.T) {
+		normals := HeightfieldNormals([][]float64{{0, 0, 0}, {0, 1, 0}, {0, 0, 0}}, 1)
+
+		if n := normals[0][1]; n.Y >= 0 || n.X != 0 {
+			t.Errorf("above the peak should lean towards -y, got %v", n)
+		}
+		if n := normals[1][2]; n.X <= 0 || n.Y != 0 {
+			t.Errorf("right of the peak should lean towards +x, got %v", n)
+		}
+		if n := normals[1][1]; !n.Equals(NewVector(0, 0, 1)) {
+			t.Errorf("the top should be flat, got %v", n)
+		}
+		if n := normals[0][0]; math.Abs(n.Mag()-1) > 1e-9 {
+			t.Errorf("should be a unit vector, got %v", n)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if n := HeightfieldNormals(nil, 1); len(n) != 0 {
+			t.Errorf("should be empty not %v", n)
+		}
+	})
+}
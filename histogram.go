@@ -0,0 +1,67 @@
+package vector
+
+import "math"
+
+// counts how many of the vectors point in each of bins equal slices around the circle
+//
+// bin 0 starts at the positive x axis and bins go round in the same direction
+// as FromAngle, towards -y. Zero length vectors have no direction and are
+// skipped. No bins, or a negative number of them, gives an empty histogram
+func HeadingHistogram(vs []Vector, bins int) []int {
+	if bins <= 0 {
+		return []int{}
+	}
+	counts := make([]int, bins)
+
+	for _, v := range vs {
+		if v.X == 0 && v.Y == 0 {
+			continue
+		}
+		counts[headingBin(v, bins)]++
+	}
+
+	return counts
+}
+
+// like HeadingHistogram but each vector is weighted by its magnitude and the
+// bins are normalised so they add up to 1
+func HeadingHistogramWeights(vs []Vector, bins int) []float64 {
+	if bins <= 0 {
+		return []float64{}
+	}
+	weights := make([]float64, bins)
+
+	total := 0.0
+	for _, v := range vs {
+		if v.X == 0 && v.Y == 0 {
+			continue
+		}
+		m := v.Mag()
+		weights[headingBin(v, bins)] += m
+		total += m
+	}
+
+	if total == 0 {
+		return weights
+	}
+
+	for i := range weights {
+		weights[i] /= total
+	}
+
+	return weights
+}
+
+func headingBin(v Vector, bins int) int {
+	a := heading(v)
+	if a < 0 {
+		a += 2 * math.Pi
+	}
+
+	b := int(a / (2 * math.Pi) * float64(bins))
+	// guard against a rounding up to exactly 2π
+	if b >= bins {
+		b = bins - 1
+	}
+	return b
+}
@@ -0,0 +1,47 @@
+package vector
+
+import "testing"
+
+func TestHeadingHistogram(t *testing.T) {
+	t.Run("one vector per quadrant", func(t *testing.T) {
+		vs := []Vector{
+			NewVector(1, -1),
+			NewVector(-1, -1),
+			NewVector(-1, 1),
+			NewVector(1, 1),
+			NewVector(),
+		}
+
+		h := HeadingHistogram(vs, 4)
+		for i, c := range h {
+			if c != 1 {
+				t.Errorf("bin %d should have 1 vector not %d (%v)", i, c, h)
+			}
+		}
+	})
+
+	t.Run("weights are normalised by magnitude", func(t *testing.T) {
+		vs := []Vector{
+			NewVector(3, 0),
+			NewVector(-1, 0),
+		}
+
+		w := HeadingHistogramWeights(vs, 2)
+		if !compare(t, w[0], 0.75) || !compare(t, w[1], 0.25) {
+			t.Errorf("weights should be [0.75 0.25] not %v", w)
+		}
+	})
+
+	t.Run("no bins", func(t *testing.T) {
+		vs := []Vector{NewVector(1, 2)}
+
+		for _, bins := range []int{0, -3} {
+			if h := HeadingHistogram(vs, bins); len(h) != 0 {
+				t.Errorf("%d bins should be empty not %v", bins, h)
+			}
+			if w := HeadingHistogramWeights(vs, bins); len(w) != 0 {
+				t.Errorf("%d bins should be empty not %v", bins, w)
+			}
+		}
+	})
+}
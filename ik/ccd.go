@@ -0,0 +1,45 @@
+package ik
+
+import vector "github.com/bawgafr/vector"
+
+// moves the chain so its end reaches for target using cyclic coordinate descent
+//
+// Working from the joint nearest the end back to the base, each joint turns
+// the rest of the chain so the end points at the target, as far as its
+// Limit allows. Stops early once the end is within Tolerance of the target.
+// Returns true if it got there
+func (c *Chain) SolveCCD(target vector.Vector, iterations int) bool {
+	n := len(c.Joints)
+	if n < 2 {
+		return false
+	}
+
+	for it := 0; it < iterations; it++ {
+		if vector.Dist(c.End(), target) <= c.Tolerance {
+			return true
+		}
+
+		for i := n - 2; i >= 0; i-- {
+			pivot := c.Joints[i]
+			toEnd := vector.Sub(c.End(), pivot)
+			toTarget := vector.Sub(target, pivot)
+			if toEnd.MagSq() == 0 || toTarget.MagSq() == 0 {
+				continue
+			}
+
+			seg := vector.Sub(c.Joints[i+1], pivot)
+			current := vector.Direction(seg).Radians()
+			turn := vector.DeltaAngle(vector.Direction(toEnd).Radians(), vector.Direction(toTarget).Radians())
+
+			wanted := vector.FromAngle(current + turn)
+			turn = vector.DeltaAngle(current, vector.Direction(c.constrain(i, wanted)).Radians())
+
+			// turning joint i swings everything after it
+			for k := i + 1; k < n; k++ {
+				c.Joints[k] = vector.Add(pivot, vector.Rotate(vector.Sub(c.Joints[k], pivot), turn))
+			}
+		}
+	}
+
+	return vector.Dist(c.End(), target) <= c.Tolerance
+}
@@ -0,0 +1,88 @@
+// Package ik has inverse kinematics solvers for 2d chains of joints
+package ik
+
+import (
+	"math"
+
+	vector "github.com/bawgafr/vector"
+)
+
+// the range of angles a segment can make with the one before it, in radians
+// using the same direction as vector.Rotate
+type Limit struct {
+	Min, Max float64
+}
+
+// no limit at all
+var Free = Limit{-math.Pi, math.Pi}
+
+// a chain of joints joined by rigid segments, eg an arm or tentacle
+//
+// Joints[0] is the fixed base. Segment i joins Joints[i] to Joints[i+1] and is
+// Lengths[i] long. If Limits is set then Limits[i] is the range of angles
+// segment i can make with segment i-1 (segment 0 is measured from the
+// positive x axis)
+type Chain struct {
+	Joints  []vector.Vector
+	Lengths []float64
+	Limits  []Limit
+	// how close the end has to get to the target to stop early
+	Tolerance float64
+}
+
+// creates an unconstrained chain through the joints, taking the segment lengths from their spacing
+func NewChain(joints ...vector.Vector) *Chain {
+	lengths := make([]float64, max(0, len(joints)-1))
+	for i := range lengths {
+		lengths[i] = vector.Dist(joints[i], joints[i+1])
+	}
+	return &Chain{Joints: joints, Lengths: lengths, Tolerance: 1e-3}
+}
+
+// the position of the last joint
+func (c *Chain) End() vector.Vector {
+	return c.Joints[len(c.Joints)-1]
+}
+
+// the total length of the chain
+func (c *Chain) Reach() float64 {
+	l := 0.0
+	for _, s := range c.Lengths {
+		l += s
+	}
+	return l
+}
+
+// the limit for segment i
+func (c *Chain) limit(i int) Limit {
+	if i < len(c.Limits) {
+		return c.Limits[i]
+	}
+	return Free
+}
+
+// the heading of the segment before segment i
+func (c *Chain) parentHeading(i int) float64 {
+	if i == 0 {
+		return 0
+	}
+	return vector.Direction(vector.Sub(c.Joints[i], c.Joints[i-1])).Radians()
+}
+
+// turns dir so segment i stays inside its limit
+func (c *Chain) constrain(i int, dir vector.Vector) vector.Vector {
+	l := c.limit(i)
+	if l == Free {
+		return dir
+	}
+
+	parent := c.parentHeading(i)
+	rel := vector.DeltaAngle(parent, vector.Direction(dir).Radians())
+	rel = max(l.Min, min(l.Max, rel))
+	return vector.FromAngle(parent + rel)
+}
+
+// places joint i+1 along dir from joint i
+func (c *Chain) place(i int, dir vector.Vector) {
+	c.Joints[i+1] = vector.Add(c.Joints[i], vector.Mult(dir, c.Lengths[i]))
+}
@@ -0,0 +1,48 @@
+package ik
+
+import vector "github.com/bawgafr/vector"
+
+// moves the chain so its end reaches for target using FABRIK (Aristidou and Lasenby)
+//
+// Each iteration drags the chain from the end to the target and then back
+// from the base to where it started. Stops early once the end is within
+// Tolerance of the target. Returns true if it got there
+func (c *Chain) Solve(target vector.Vector, iterations int) bool {
+	n := len(c.Joints)
+	if n < 2 {
+		return false
+	}
+
+	base := c.Joints[0]
+
+	for it := 0; it < iterations; it++ {
+		if vector.Dist(c.End(), target) <= c.Tolerance {
+			return true
+		}
+
+		// forwards: pin the end to the target
+		c.Joints[n-1] = target
+		for i := n - 2; i >= 0; i-- {
+			dir := direction(c.Joints[i+1], c.Joints[i])
+			c.Joints[i] = vector.Add(c.Joints[i+1], vector.Mult(dir, c.Lengths[i]))
+		}
+
+		// backwards: pin the base back where it was
+		c.Joints[0] = base
+		for i := 0; i < n-1; i++ {
+			dir := direction(c.Joints[i], c.Joints[i+1])
+			c.place(i, c.constrain(i, dir))
+		}
+	}
+
+	return vector.Dist(c.End(), target) <= c.Tolerance
+}
+
+// the unit vector from a to b, or the x axis if they are on top of each other
+func direction(a, b vector.Vector) vector.Vector {
+	d := vector.Sub(b, a)
+	if d.MagSq() == 0 {
+		return vector.NewVector(1, 0)
+	}
+	return vector.Normalise(d)
+}
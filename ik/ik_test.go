@@ -0,0 +1,100 @@
+package ik
+
+import (
+	"math"
+	"testing"
+
+	vector "github.com/bawgafr/vector"
+)
+
+func arm() *Chain {
+	return NewChain(
+		vector.NewVector(0, 0),
+		vector.NewVector(10, 0),
+		vector.NewVector(20, 0),
+		vector.NewVector(30, 0),
+	)
+}
+
+// checks the segments are still the right length
+func checkLengths(t *testing.T, c *Chain) {
+	t.Helper()
+	for i, l := range c.Lengths {
+		if d := vector.Dist(c.Joints[i], c.Joints[i+1]); math.Abs(d-l) > 1e-6 {
+			t.Errorf("segment %d should be %f long not %f", i, l, d)
+		}
+	}
+}
+
+func TestFABRIK(t *testing.T) {
+	t.Run("reaches a target in range", func(t *testing.T) {
+		c := arm()
+		target := vector.NewVector(12, -15)
+
+		if !c.Solve(target, 50) {
+			t.Errorf("should reach %v, got to %v", target, c.End())
+		}
+		if !c.Joints[0].Equals(vector.NewVector()) {
+			t.Errorf("base moved to %v", c.Joints[0])
+		}
+		checkLengths(t, c)
+	})
+
+	t.Run("stretches towards a target out of range", func(t *testing.T) {
+		c := arm()
+
+		if c.Solve(vector.NewVector(0, 100), 50) {
+			t.Error("should not reach a target out of range")
+		}
+		if vector.Dist(c.End(), vector.NewVector(0, 30)) > 1e-3 {
+			t.Errorf("should point straight at the target, end at %v", c.End())
+		}
+		checkLengths(t, c)
+	})
+
+	t.Run("respects limits", func(t *testing.T) {
+		c := arm()
+		c.Limits = []Limit{Free, {-0.3, 0.3}, {-0.3, 0.3}}
+
+		c.Solve(vector.NewVector(5, 5), 50)
+
+		for i := 1; i < len(c.Lengths); i++ {
+			rel := vector.DeltaAngle(c.parentHeading(i), vector.Direction(vector.Sub(c.Joints[i+1], c.Joints[i])).Radians())
+			if math.Abs(rel) > 0.3+1e-9 {
+				t.Errorf("joint %d bent %f", i, rel)
+			}
+		}
+		checkLengths(t, c)
+	})
+}
+
+func TestCCD(t *testing.T) {
+	t.Run("reaches a target in range", func(t *testing.T) {
+		c := arm()
+		target := vector.NewVector(-5, 18)
+
+		if !c.SolveCCD(target, 100) {
+			t.Errorf("should reach %v, got to %v", target, c.End())
+		}
+		if !c.Joints[0].Equals(vector.NewVector()) {
+			t.Errorf("base moved to %v", c.Joints[0])
+		}
+		checkLengths(t, c)
+	})
+
+	t.Run("respects limits", func(t *testing.T) {
+		c := arm()
+		c.Limits = []Limit{{-0.5, 0.5}, {-1, 1}, {-1, 1}}
+
+		c.SolveCCD(vector.NewVector(-10, -10), 100)
+
+		for i := range c.Lengths {
+			l := c.limit(i)
+			rel := vector.DeltaAngle(c.parentHeading(i), vector.Direction(vector.Sub(c.Joints[i+1], c.Joints[i])).Radians())
+			if rel < l.Min-1e-9 || rel > l.Max+1e-9 {
+				t.Errorf("joint %d bent %f, outside %v", i, rel, l)
+			}
+		}
+		checkLengths(t, c)
+	})
+}
@@ -0,0 +1,53 @@
+// Package integrate steps ordinary differential equations whose state is a Vector
+//
+// deriv returns the rate of change of the state at time t. Each function
+// returns the state dt later
+package integrate
+
+import vector "github.com/bawgafr/vector"
+
+// one step of the classic fourth order Runge-Kutta method
+func RK4(state vector.Vector, deriv func(t float64, s vector.Vector) vector.Vector, t, dt float64) vector.Vector {
+	k1 := deriv(t, state)
+	k2 := deriv(t+dt/2, vector.Add(state, vector.Mult(k1, dt/2)))
+	k3 := deriv(t+dt/2, vector.Add(state, vector.Mult(k2, dt/2)))
+	k4 := deriv(t+dt, vector.Add(state, vector.Mult(k3, dt)))
+
+	sum := vector.Add(vector.Add(k1, vector.Mult(k2, 2)), vector.Add(vector.Mult(k3, 2), k4))
+	return vector.Add(state, vector.Mult(sum, dt/6))
+}
+
+// one step of the midpoint method (second order)
+func Midpoint(state vector.Vector, deriv func(t float64, s vector.Vector) vector.Vector, t, dt float64) vector.Vector {
+	k1 := deriv(t, state)
+	k2 := deriv(t+dt/2, vector.Add(state, vector.Mult(k1, dt/2)))
+	return vector.Add(state, vector.Mult(k2, dt))
+}
+
+// one step of Heun's method (the improved Euler method, second order)
+func Heun(state vector.Vector, deriv func(t float64, s vector.Vector) vector.Vector, t, dt float64) vector.Vector {
+	k1 := deriv(t, state)
+	k2 := deriv(t+dt, vector.Add(state, vector.Mult(k1, dt)))
+	return vector.Add(state, vector.Mult(vector.Add(k1, k2), dt/2))
+}
+
+// one RK4 step of a body with position and velocity, where accel gives the
+// acceleration at time t. Returns the new position and velocity
+func RK4PosVel(pos, vel vector.Vector, accel func(t float64, pos, vel vector.Vector) vector.Vector, t, dt float64) (vector.Vector, vector.Vector) {
+	a1 := accel(t, pos, vel)
+	p1, v1 := vel, a1
+
+	p2 := vector.Add(vel, vector.Mult(a1, dt/2))
+	v2 := accel(t+dt/2, vector.Add(pos, vector.Mult(p1, dt/2)), p2)
+
+	p3 := vector.Add(vel, vector.Mult(v2, dt/2))
+	v3 := accel(t+dt/2, vector.Add(pos, vector.Mult(p2, dt/2)), p3)
+
+	p4 := vector.Add(vel, vector.Mult(v3, dt))
+	v4 := accel(t+dt, vector.Add(pos, vector.Mult(p3, dt)), p4)
+
+	dp := vector.Add(vector.Add(p1, vector.Mult(p2, 2)), vector.Add(vector.Mult(p3, 2), p4))
+	dv := vector.Add(vector.Add(v1, vector.Mult(v2, 2)), vector.Add(vector.Mult(v3, 2), v4))
+
+	return vector.Add(pos, vector.Mult(dp, dt/6)), vector.Add(vel, vector.Mult(dv, dt/6))
+}
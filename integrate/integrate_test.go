@@ -0,0 +1,59 @@
+package integrate
+
+import (
+	"math"
+	"testing"
+
+	vector "github.com/bawgafr/vector"
+)
+
+// exponential decay on every component, s(t) = s0 e^-t
+func decay(t float64, s vector.Vector) vector.Vector {
+	return vector.Mult(s, -1)
+}
+
+func TestSteppers(t *testing.T) {
+	steppers := []struct {
+		name string
+		step func(vector.Vector, func(float64, vector.Vector) vector.Vector, float64, float64) vector.Vector
+		tol  float64
+	}{
+		{"rk4", RK4, 1e-8},
+		{"midpoint", Midpoint, 1e-4},
+		{"heun", Heun, 1e-4},
+	}
+
+	for _, s := range steppers {
+		t.Run(s.name, func(t *testing.T) {
+			state := vector.NewVector(1, 2, -3)
+			dt := 0.01
+			for i := 0; i < 100; i++ {
+				state = s.step(state, decay, float64(i)*dt, dt)
+			}
+
+			expected := vector.Mult(vector.NewVector(1, 2, -3), math.Exp(-1))
+			if d := vector.Dist(state, expected); d > s.tol {
+				t.Errorf("%v is %g from %v", state, d, expected)
+			}
+		})
+	}
+}
+
+func TestRK4PosVel(t *testing.T) {
+	// a spring, x'' = -x, takes 2π to go round once
+	spring := func(t float64, pos, vel vector.Vector) vector.Vector {
+		return vector.Mult(pos, -1)
+	}
+
+	pos := vector.NewVector(1, 0)
+	vel := vector.NewVector(0, 1)
+	n := 1000
+	dt := 2 * math.Pi / float64(n)
+	for i := 0; i < n; i++ {
+		pos, vel = RK4PosVel(pos, vel, spring, float64(i)*dt, dt)
+	}
+
+	if vector.Dist(pos, vector.NewVector(1, 0)) > 1e-8 || vector.Dist(vel, vector.NewVector(0, 1)) > 1e-8 {
+		t.Errorf("should come back round to the start %v %v", pos, vel)
+	}
+}
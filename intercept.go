@@ -0,0 +1,43 @@
+package vector
+
+import "math"
+
+// where to aim a projectile of projectileSpeed so it hits a target moving at a constant velocity
+//
+// Solves |targetPos + targetVel*t - shooterPos| = projectileSpeed*t for the
+// earliest positive t. Returns false if the projectile can never catch the target
+func InterceptPoint(shooterPos Vector, projectileSpeed float64, targetPos, targetVel Vector) (Vector, bool) {
+	d := Sub(targetPos, shooterPos)
+
+	a := targetVel.MagSq() - projectileSpeed*projectileSpeed
+	b := 2 * d.DotProduct(targetVel)
+	c := d.MagSq()
+
+	t := -1.0
+	if math.Abs(a) < 1e-12 {
+		// same speed as the target so the equation is linear
+		if b != 0 {
+			t = -c / b
+		}
+	} else {
+		disc := b*b - 4*a*c
+		if disc < 0 {
+			return Vector{}, false
+		}
+
+		sq := math.Sqrt(disc)
+		t1 := (-b - sq) / (2 * a)
+		t2 := (-b + sq) / (2 * a)
+
+		t = min(t1, t2)
+		if t < 0 {
+			t = max(t1, t2)
+		}
+	}
+
+	if t < 0 {
+		return Vector{}, false
+	}
+
+	return Add(targetPos, Mult(targetVel, t)), true
+}
@@ -0,0 +1,39 @@
+package vector
+
+import "testing"
+
+func TestInterceptPoint(t *testing.T) {
+	t.Run("stationary target", func(t *testing.T) {
+		p, ok := InterceptPoint(NewVector(), 5, NewVector(10, 0), NewVector())
+
+		if !ok || !p.Equals(NewVector(10, 0)) {
+			t.Errorf("should aim at the target %v %v", p, ok)
+		}
+	})
+
+	t.Run("crossing target", func(t *testing.T) {
+		shooter := NewVector()
+		target := NewVector(30, -40)
+		vel := NewVector(0, 8)
+
+		p, ok := InterceptPoint(shooter, 10, target, vel)
+		if !ok {
+			t.Fatal("should be able to hit the target")
+		}
+
+		// the projectile and target should take the same time to get there
+		tp := Dist(shooter, p) / 10
+		tt := Dist(target, p) / vel.Mag()
+		if !compare(t, tp, tt) {
+			t.Errorf("projectile takes %f, target takes %f", tp, tt)
+		}
+	})
+
+	t.Run("target running away too fast", func(t *testing.T) {
+		_, ok := InterceptPoint(NewVector(), 1, NewVector(10, 0), NewVector(2, 0))
+
+		if ok {
+			t.Error("should not be able to catch the target")
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package vector
+
+import (
+	"slices"
+	"time"
+)
+
+// stores timestamped positions of a remote entity and gives smooth positions between them
+//
+// Render a little behind the newest snapshot (eg now minus 100ms) so there's
+// usually a snapshot either side to interpolate between. Past the newest one
+// the position carries on at the last velocity for up to MaxExtrapolation,
+// then holds; 0 means it holds straight away. Only the last Capacity
+// snapshots are kept
+type InterpBuffer struct {
+	MaxExtrapolation time.Duration
+	Capacity         int
+
+	snapshots []timedVector
+}
+
+type timedVector struct {
+	t time.Time
+	v Vector
+}
+
+// creates an InterpBuffer that keeps 32 snapshots
+func NewInterpBuffer(maxExtrapolation time.Duration) *InterpBuffer {
+	return &InterpBuffer{MaxExtrapolation: maxExtrapolation, Capacity: 32}
+}
+
+// adds the position v at time t. Snapshots that arrive out of order are put in their place
+func (b *InterpBuffer) Add(t time.Time, v Vector) {
+	i, _ := slices.BinarySearchFunc(b.snapshots, t, func(s timedVector, t time.Time) int {
+		return s.t.Compare(t)
+	})
+	b.snapshots = slices.Insert(b.snapshots, i, timedVector{t, v})
+
+	if b.Capacity > 0 && len(b.snapshots) > b.Capacity {
+		b.snapshots = slices.Delete(b.snapshots, 0, len(b.snapshots)-b.Capacity)
+	}
+}
+
+// the position at renderTime. Before the first snapshot it's the first
+// position, and with no snapshots it's zero
+func (b *InterpBuffer) At(renderTime time.Time) Vector {
+	n := len(b.snapshots)
+	if n == 0 {
+		return Vector{}
+	}
+	if !renderTime.After(b.snapshots[0].t) {
+		return b.snapshots[0].v
+	}
+
+	i, _ := slices.BinarySearchFunc(b.snapshots, renderTime, func(s timedVector, t time.Time) int {
+		return s.t.Compare(t)
+	})
+	if i < n {
+		return lerpTimed(b.snapshots[i-1], b.snapshots[i], renderTime)
+	}
+
+	last := b.snapshots[n-1]
+	if n == 1 || b.MaxExtrapolation <= 0 {
+		return last.v
+	}
+	ahead := min(renderTime.Sub(last.t), b.MaxExtrapolation)
+	return lerpTimed(b.snapshots[n-2], last, last.t.Add(ahead))
+}
+
+// forgets all the snapshots
+func (b *InterpBuffer) Reset() {
+	b.snapshots = b.snapshots[:0]
+}
+
+// the position at t on the line through a and b, which can be past b
+func lerpTimed(a, b timedVector, t time.Time) Vector {
+	span := b.t.Sub(a.t)
+	if span <= 0 {
+		return b.v
+	}
+	return Lerp(a.v, b.v, t.Sub(a.t).Seconds()/span.Seconds())
+}
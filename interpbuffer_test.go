@@ -0,0 +1,57 @@
+package vector
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInterpBuffer(t *testing.T) {
+	start := time.Unix(1000, 0)
+	at := func(ms int) time.Time {
+		return start.Add(time.Duration(ms) * time.Millisecond)
+	}
+
+	b := NewInterpBuffer(50 * time.Millisecond)
+	b.Add(at(0), NewVector(0, 0))
+	b.Add(at(200), NewVector(20, 10))
+	// arrives late but belongs in the middle
+	b.Add(at(100), NewVector(10, 0))
+
+	for _, tc := range []struct {
+		ms       int
+		expected Vector
+	}{
+		{-50, NewVector(0, 0)},
+		{50, NewVector(5, 0)},
+		{100, NewVector(10, 0)},
+		{150, NewVector(15, 5)},
+		{220, NewVector(22, 12)},
+		{400, NewVector(25, 15)},
+	} {
+		if p := b.At(at(tc.ms)); !p.Equals(tc.expected) {
+			t.Errorf("at %dms should be %v not %v", tc.ms, tc.expected, p)
+		}
+	}
+
+	t.Run("holds without extrapolation", func(t *testing.T) {
+		h := NewInterpBuffer(0)
+		h.Add(at(0), NewVector(0, 0))
+		h.Add(at(100), NewVector(10, 0))
+
+		if p := h.At(at(300)); !p.Equals(NewVector(10, 0)) {
+			t.Errorf("should hold at (10, 0) not %v", p)
+		}
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		c := NewInterpBuffer(0)
+		c.Capacity = 2
+		for i := range 5 {
+			c.Add(at(i*100), NewVector(float64(i), 0))
+		}
+
+		if p := c.At(at(0)); !p.Equals(NewVector(3, 0)) {
+			t.Errorf("only the last 2 should be kept, got %v", p)
+		}
+	})
+}
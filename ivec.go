@@ -0,0 +1,28 @@
+package vector
+
+import "math"
+
+// an integer 2d vector, used for grid cells and pixels
+type IVec struct {
+	X, Y int
+}
+
+// adds the two IVecs and returns a new IVec
+func (a IVec) Add(b IVec) IVec {
+	return IVec{a.X + b.X, a.Y + b.Y}
+}
+
+// subtract b from a and return a new IVec
+func (a IVec) Sub(b IVec) IVec {
+	return IVec{a.X - b.X, a.Y - b.Y}
+}
+
+// converts to a float Vector
+func (a IVec) Vector() Vector {
+	return NewVector(float64(a.X), float64(a.Y))
+}
+
+// the grid cell containing the point for square cells of cellSize
+func CellOf(p Vector, cellSize float64) IVec {
+	return IVec{int(math.Floor(p.X / cellSize)), int(math.Floor(p.Y / cellSize))}
+}
@@ -0,0 +1,145 @@
+package vector
+
+import (
+	"math"
+	"slices"
+)
+
+// one edge of the sample grid, either the one going right from sample (i, j) or the one going down
+type gridEdge struct {
+	i, j     int
+	vertical bool
+}
+
+// the contour lines where field crosses iso in the xy plane (marching squares)
+//
+// The field is sampled on a grid across bounds with cells no bigger than
+// resolution, and the crossings are found by interpolating along the cell
+// edges. Infinite samples put the crossing on the other end of the edge. It
+// works with sdf shapes (iso 0 for their outlines), Noise (for
+// terrain outlines) and Metaballs. Closed loops end with their first point
+// repeated, lines that run out of bounds don't
+func MarchingSquares(field func(Vector) float64, bounds AABB, resolution, iso float64) [][]Vector {
+	size := bounds.Size()
+	if resolution <= 0 || size.X <= 0 || size.Y <= 0 {
+		return [][]Vector{}
+	}
+
+	cols := max(int(math.Ceil(size.X/resolution)), 1)
+	rows := max(int(math.Ceil(size.Y/resolution)), 1)
+	dx, dy := size.X/float64(cols), size.Y/float64(rows)
+
+	pos := func(i, j int) Vector {
+		return NewVector(bounds.Min.X+float64(i)*dx, bounds.Min.Y+float64(j)*dy, bounds.Min.Z)
+	}
+	values := make([]float64, (cols+1)*(rows+1))
+	for j := 0; j <= rows; j++ {
+		for i := 0; i <= cols; i++ {
+			values[j*(cols+1)+i] = field(pos(i, j))
+		}
+	}
+	value := func(i, j int) float64 { return values[j*(cols+1)+i] }
+
+	// where the contour crosses an edge, found by interpolating the samples at its ends
+	crossing := func(e gridEdge) Vector {
+		i2, j2 := e.i+1, e.j
+		if e.vertical {
+			i2, j2 = e.i, e.j+1
+		}
+		a, b := value(e.i, e.j), value(i2, j2)
+		// an infinite sample says nothing about where the crossing is, so
+		// put it on the finite end
+		switch {
+		case math.IsInf(a, 0) && math.IsInf(b, 0):
+			return Lerp(pos(e.i, e.j), pos(i2, j2), 0.5)
+		case math.IsInf(a, 0):
+			return pos(i2, j2)
+		case math.IsInf(b, 0):
+			return pos(e.i, e.j)
+		}
+		t := max(0, min(1, (iso-a)/(b-a)))
+		return Lerp(pos(e.i, e.j), pos(i2, j2), t)
+	}
+
+	// each edge is in at most two segments, so the segments join into chains
+	links := map[gridEdge][]gridEdge{}
+	order := []gridEdge{}
+	link := func(a, b gridEdge) {
+		for _, e := range []gridEdge{a, b} {
+			if _, ok := links[e]; !ok {
+				order = append(order, e)
+			}
+		}
+		links[a] = append(links[a], b)
+		links[b] = append(links[b], a)
+	}
+
+	for j := range rows {
+		for i := range cols {
+			// corners and edges go clockwise from the top left
+			corners := [4]float64{value(i, j), value(i+1, j), value(i+1, j+1), value(i, j+1)}
+			edges := [4]gridEdge{{i, j, false}, {i + 1, j, true}, {i, j + 1, false}, {i, j, true}}
+
+			crossed := []int{}
+			for k := range 4 {
+				if (corners[k] > iso) != (corners[(k+1)%4] > iso) {
+					crossed = append(crossed, k)
+				}
+			}
+
+			switch len(crossed) {
+			case 2:
+				link(edges[crossed[0]], edges[crossed[1]])
+			case 4:
+				// a saddle, so use the middle of the cell to decide which
+				// pair of opposite corners is joined up
+				centre := (corners[0] + corners[1] + corners[2] + corners[3]) / 4
+				if (centre > iso) == (corners[0] > iso) {
+					link(edges[0], edges[1])
+					link(edges[2], edges[3])
+				} else {
+					link(edges[3], edges[0])
+					link(edges[1], edges[2])
+				}
+			}
+		}
+	}
+
+	visited := map[gridEdge]bool{}
+	walk := func(start gridEdge) []Vector {
+		line := []Vector{crossing(start)}
+		visited[start] = true
+		for cur := start; ; {
+			next, ok := gridEdge{}, false
+			for _, n := range links[cur] {
+				if !visited[n] {
+					next, ok = n, true
+					break
+				}
+			}
+			if !ok {
+				if len(line) > 2 && slices.Contains(links[cur], start) {
+					line = append(line, line[0])
+				}
+				return line
+			}
+			visited[next] = true
+			line = append(line, crossing(next))
+			cur = next
+		}
+	}
+
+	lines := [][]Vector{}
+	// lines that reach the bounds first so they're walked from one end
+	for _, e := range order {
+		if !visited[e] && len(links[e]) == 1 {
+			lines = append(lines, walk(e))
+		}
+	}
+	for _, e := range order {
+		if !visited[e] {
+			lines = append(lines, walk(e))
+		}
+	}
+	return lines
+}
@@ -0,0 +1,88 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMarchingSquares(t *testing.T) {
+	t.Run("circle", func(t *testing.T) {
+		circle := func(p Vector) float64 { return Mag(Sub(p, NewVector(1, 2))) - 4 }
+		lines := MarchingSquares(circle, NewAABB(NewVector(-5, -5), NewVector(7, 9)), 0.5, 0)
+
+		if len(lines) != 1 {
+			t.Fatalf("should be one line not %d", len(lines))
+		}
+		if l := PolylineLength(lines[0]); math.Abs(l-8*math.Pi) > 0.2 {
+			t.Errorf("length should be about 8π not %f", l)
+		}
+	})
+
+	t.Run("iso level", func(t *testing.T) {
+		ramp := func(p Vector) float64 { return p.X }
+		lines := MarchingSquares(ramp, NewAABB(NewVector(0, 0), NewVector(10, 4)), 1, 3.3)
+
+		if len(lines) != 1 || len(lines[0]) != 5 {
+			t.Fatalf("should be one straight line of 5 points, got %v", lines)
+		}
+		for _, p := range lines[0] {
+			if math.Abs(p.X-3.3) > 1e-9 {
+				t.Errorf("%v should be on x = 3.3", p)
+			}
+		}
+	})
+
+	t.Run("saddle", func(t *testing.T) {
+		// high in the top left and bottom right corners only, with a low middle
+		f := func(p Vector) float64 { return p.X * p.Y }
+		lines := MarchingSquares(f, NewAABB(NewVector(-1, -1), NewVector(1, 1)), 2, 0.5)
+
+		if len(lines) != 2 {
+			t.Errorf("the two high corners should be cut off separately, got %d lines", len(lines))
+		}
+	})
+
+	t.Run("noise terrain", func(t *testing.T) {
+		terrain := func(p Vector) float64 { return Noise(p.X*0.1, p.Y*0.1, 0) }
+		bounds := NewAABB(NewVector(0, 0), NewVector(50, 50))
+
+		for _, line := range MarchingSquares(terrain, bounds, 1, 0.5) {
+			for _, p := range line {
+				if !bounds.Contains(p) {
+					t.Fatalf("%v is outside the bounds", p)
+				}
+			}
+		}
+	})
+
+	t.Run("infinite samples", func(t *testing.T) {
+		// +Inf on the node at (1, 1) and 0 everywhere else
+		spike := func(p Vector) float64 {
+			if p.Equals(NewVector(1, 1)) {
+				return math.Inf(1)
+			}
+			return 0
+		}
+		lines := MarchingSquares(spike, NewAABB(NewVector(0, 0), NewVector(2, 2)), 1, 0.5)
+
+		if len(lines) != 1 {
+			t.Fatalf("should be one line round the spike not %d", len(lines))
+		}
+		for _, p := range lines[0] {
+			if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) {
+				t.Fatalf("should only have finite points, got %v", lines[0])
+			}
+		}
+	})
+
+	t.Run("nothing to find", func(t *testing.T) {
+		flat := func(Vector) float64 { return 1 }
+
+		if n := len(MarchingSquares(flat, NewAABB(NewVector(0, 0), NewVector(5, 5)), 1, 0)); n != 0 {
+			t.Errorf("should find no lines not %d", n)
+		}
+		if n := len(MarchingSquares(flat, NewAABB(NewVector(0, 0), NewVector(5, 5)), 0, 0)); n != 0 {
+			t.Errorf("zero resolution should find no lines not %d", n)
+		}
+	})
+}
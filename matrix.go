@@ -0,0 +1,283 @@
+package vector
+
+import "math"
+
+// a 3x3 matrix in row major order, used as a 2d transform in homogeneous coordinates
+//
+// Vectors are columns so m.Mul(n) applies n first, then m
+type Mat3 [9]float64
+
+// a 4x4 matrix in row major order, used as a 3d transform in homogeneous coordinates
+//
+// Vectors are columns so m.Mul(n) applies n first, then m. Rotations turn the
+// same way as Rotate: clockwise looking back along the axis towards the origin
+type Mat4 [16]float64
+
+// the identity matrix
+func Identity3() Mat3 {
+	return Mat3{
+		1, 0, 0,
+		0, 1, 0,
+		0, 0, 1,
+	}
+}
+
+// moves points by tx, ty
+func Translate3(tx, ty float64) Mat3 {
+	return Mat3{
+		1, 0, tx,
+		0, 1, ty,
+		0, 0, 1,
+	}
+}
+
+// rotates points about the origin by angle, the same as Rotate
+func Rotate3(angle float64) Mat3 {
+	c := math.Cos(-angle)
+	s := math.Sin(-angle)
+	return Mat3{
+		c, -s, 0,
+		s, c, 0,
+		0, 0, 1,
+	}
+}
+
+// scales points about the origin
+func Scale3(sx, sy float64) Mat3 {
+	return Mat3{
+		sx, 0, 0,
+		0, sy, 0,
+		0, 0, 1,
+	}
+}
+
+// the matrix product m * n
+func (m Mat3) Mul(n Mat3) Mat3 {
+	var out Mat3
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			for k := 0; k < 3; k++ {
+				out[r*3+c] += m[r*3+k] * n[k*3+c]
+			}
+		}
+	}
+	return out
+}
+
+// transforms the 2d point v (x, y, 1). Z is passed through unchanged
+func (m Mat3) Transform(v Vector) Vector {
+	x := m[0]*v.X + m[1]*v.Y + m[2]
+	y := m[3]*v.X + m[4]*v.Y + m[5]
+	w := m[6]*v.X + m[7]*v.Y + m[8]
+	if w != 1 && w != 0 {
+		x /= w
+		y /= w
+	}
+	return Vector{x, y, v.Z}
+}
+
+// multiplies all three components of v by the matrix, without treating it as a 2d point
+func (m Mat3) MulVec(v Vector) Vector {
+	return Vector{
+		m[0]*v.X + m[1]*v.Y + m[2]*v.Z,
+		m[3]*v.X + m[4]*v.Y + m[5]*v.Z,
+		m[6]*v.X + m[7]*v.Y + m[8]*v.Z,
+	}
+}
+
+// the transpose of the matrix
+func (m Mat3) Transpose() Mat3 {
+	return Mat3{
+		m[0], m[3], m[6],
+		m[1], m[4], m[7],
+		m[2], m[5], m[8],
+	}
+}
+
+// the determinant of the matrix
+func (m Mat3) Determinant() float64 {
+	return m[0]*(m[4]*m[8]-m[5]*m[7]) -
+		m[1]*(m[3]*m[8]-m[5]*m[6]) +
+		m[2]*(m[3]*m[7]-m[4]*m[6])
+}
+
+// the inverse of the matrix, or false if it has none
+func (m Mat3) Inverse() (Mat3, bool) {
+	d := m.Determinant()
+	if d == 0 {
+		return Mat3{}, false
+	}
+
+	inv := Mat3{
+		m[4]*m[8] - m[5]*m[7], m[2]*m[7] - m[1]*m[8], m[1]*m[5] - m[2]*m[4],
+		m[5]*m[6] - m[3]*m[8], m[0]*m[8] - m[2]*m[6], m[2]*m[3] - m[0]*m[5],
+		m[3]*m[7] - m[4]*m[6], m[1]*m[6] - m[0]*m[7], m[0]*m[4] - m[1]*m[3],
+	}
+	for i := range inv {
+		inv[i] /= d
+	}
+	return inv, true
+}
+
+// the identity matrix
+func Identity4() Mat4 {
+	return Mat4{
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	}
+}
+
+// moves points by t
+func Translate4(t Vector) Mat4 {
+	return Mat4{
+		1, 0, 0, t.X,
+		0, 1, 0, t.Y,
+		0, 0, 1, t.Z,
+		0, 0, 0, 1,
+	}
+}
+
+// scales points about the origin by s.X, s.Y and s.Z
+func Scale4(s Vector) Mat4 {
+	return Mat4{
+		s.X, 0, 0, 0,
+		0, s.Y, 0, 0,
+		0, 0, s.Z, 0,
+		0, 0, 0, 1,
+	}
+}
+
+// rotates points by angle about the x axis
+func RotateX4(angle float64) Mat4 {
+	return RotateAxis4(NewVector(1, 0, 0), angle)
+}
+
+// rotates points by angle about the y axis
+func RotateY4(angle float64) Mat4 {
+	return RotateAxis4(NewVector(0, 1, 0), angle)
+}
+
+// rotates points by angle about the z axis, the same as Rotate does in 2d
+func RotateZ4(angle float64) Mat4 {
+	return RotateAxis4(NewVector(0, 0, 1), angle)
+}
+
+// rotates points by angle about axis (which doesn't need to be a unit
+// vector). A zero axis gives the identity, like RotateAxis
+func RotateAxis4(axis Vector, angle float64) Mat4 {
+	if axis.MagSq() == 0 {
+		return Identity4()
+	}
+	a := Normalise(axis)
+	c := math.Cos(-angle)
+	s := math.Sin(-angle)
+	t := 1 - c
+
+	return Mat4{
+		t*a.X*a.X + c, t*a.X*a.Y - s*a.Z, t*a.X*a.Z + s*a.Y, 0,
+		t*a.X*a.Y + s*a.Z, t*a.Y*a.Y + c, t*a.Y*a.Z - s*a.X, 0,
+		t*a.X*a.Z - s*a.Y, t*a.Y*a.Z + s*a.X, t*a.Z*a.Z + c, 0,
+		0, 0, 0, 1,
+	}
+}
+
+// an OpenGL style perspective projection. fovY is the vertical field of view in radians
+func Perspective4(fovY, aspect, near, far float64) Mat4 {
+	f := 1 / math.Tan(fovY/2)
+	return Mat4{
+		f / aspect, 0, 0, 0,
+		0, f, 0, 0,
+		0, 0, (far + near) / (near - far), 2 * far * near / (near - far),
+		0, 0, -1, 0,
+	}
+}
+
+// the matrix product m * n
+func (m Mat4) Mul(n Mat4) Mat4 {
+	var out Mat4
+	for r := 0; r < 4; r++ {
+		for c := 0; c < 4; c++ {
+			for k := 0; k < 4; k++ {
+				out[r*4+c] += m[r*4+k] * n[k*4+c]
+			}
+		}
+	}
+	return out
+}
+
+// transforms the point v (x, y, z, 1), dividing by w for projections
+func (m Mat4) Transform(v Vector) Vector {
+	x := m[0]*v.X + m[1]*v.Y + m[2]*v.Z + m[3]
+	y := m[4]*v.X + m[5]*v.Y + m[6]*v.Z + m[7]
+	z := m[8]*v.X + m[9]*v.Y + m[10]*v.Z + m[11]
+	w := m[12]*v.X + m[13]*v.Y + m[14]*v.Z + m[15]
+	if w != 1 && w != 0 {
+		return Vector{x / w, y / w, z / w}
+	}
+	return Vector{x, y, z}
+}
+
+// transforms the direction v (x, y, z, 0), ignoring any translation
+func (m Mat4) TransformDir(v Vector) Vector {
+	return Vector{
+		m[0]*v.X + m[1]*v.Y + m[2]*v.Z,
+		m[4]*v.X + m[5]*v.Y + m[6]*v.Z,
+		m[8]*v.X + m[9]*v.Y + m[10]*v.Z,
+	}
+}
+
+// the transpose of the matrix
+func (m Mat4) Transpose() Mat4 {
+	var out Mat4
+	for r := 0; r < 4; r++ {
+		for c := 0; c < 4; c++ {
+			out[c*4+r] = m[r*4+c]
+		}
+	}
+	return out
+}
+
+// the inverse of the matrix, or false if it has none (Gauss-Jordan elimination)
+func (m Mat4) Inverse() (Mat4, bool) {
+	a := m
+	inv := Identity4()
+
+	for col := 0; col < 4; col++ {
+		// pick the biggest pivot for stability
+		pivot := col
+		for r := col + 1; r < 4; r++ {
+			if math.Abs(a[r*4+col]) > math.Abs(a[pivot*4+col]) {
+				pivot = r
+			}
+		}
+		if a[pivot*4+col] == 0 {
+			return Mat4{}, false
+		}
+
+		for c := 0; c < 4; c++ {
+			a[col*4+c], a[pivot*4+c] = a[pivot*4+c], a[col*4+c]
+			inv[col*4+c], inv[pivot*4+c] = inv[pivot*4+c], inv[col*4+c]
+		}
+
+		p := a[col*4+col]
+		for c := 0; c < 4; c++ {
+			a[col*4+c] /= p
+			inv[col*4+c] /= p
+		}
+
+		for r := 0; r < 4; r++ {
+			if r == col {
+				continue
+			}
+			f := a[r*4+col]
+			for c := 0; c < 4; c++ {
+				a[r*4+c] -= f * a[col*4+c]
+				inv[r*4+c] -= f * inv[col*4+c]
+			}
+		}
+	}
+
+	return inv, true
+}
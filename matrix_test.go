@@ -0,0 +1,91 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMat3(t *testing.T) {
+	t.Run("rotate matches Rotate", func(t *testing.T) {
+		v := NewVector(3, -2)
+
+		if r := Rotate3(0.8).Transform(v); !r.Equals(Rotate(v, 0.8)) {
+			t.Errorf("should be %v not %v", Rotate(v, 0.8), r)
+		}
+	})
+
+	t.Run("compose translate after scale", func(t *testing.T) {
+		m := Translate3(10, 5).Mul(Scale3(2, 3))
+
+		if p := m.Transform(NewVector(1, 1)); !p.Equals(NewVector(12, 8)) {
+			t.Errorf("should be {12, 8} not %v", p)
+		}
+	})
+
+	t.Run("inverse", func(t *testing.T) {
+		m := Translate3(4, -1).Mul(Rotate3(1.1)).Mul(Scale3(2, 0.5))
+		inv, ok := m.Inverse()
+		if !ok {
+			t.Fatal("should be invertible")
+		}
+
+		p := NewVector(7, 3)
+		if back := inv.Transform(m.Transform(p)); !back.Equals(p) {
+			t.Errorf("should come back to %v not %v", p, back)
+		}
+		if _, ok := Scale3(0, 1).Inverse(); ok {
+			t.Error("a flattening scale should not be invertible")
+		}
+	})
+}
+
+func TestMat4(t *testing.T) {
+	t.Run("rotate z matches Rotate", func(t *testing.T) {
+		v := NewVector(1, 2, 5)
+		r := RotateZ4(math.Pi / 3).Transform(v)
+
+		expected := Rotate(v, math.Pi/3)
+		expected.Z = 5
+		if !r.Equals(expected) {
+			t.Errorf("should be %v not %v", expected, r)
+		}
+	})
+
+	t.Run("rotations keep lengths", func(t *testing.T) {
+		v := NewVector(1, 2, 3)
+		m := RotateX4(0.3).Mul(RotateY4(1.2)).Mul(RotateAxis4(NewVector(1, 1, 1), 2))
+
+		if r := m.Transform(v); !compare(t, r.Mag(), v.Mag()) {
+			t.Errorf("length changed from %f to %f", v.Mag(), r.Mag())
+		}
+	})
+
+	t.Run("directions ignore translation", func(t *testing.T) {
+		m := Translate4(NewVector(5, 5, 5)).Mul(Scale4(NewVector(2, 2, 2)))
+
+		if d := m.TransformDir(NewVector(1, 0, 0)); !d.Equals(NewVector(2, 0, 0)) {
+			t.Errorf("should be {2, 0, 0} not %v", d)
+		}
+		if p := m.Transform(NewVector(1, 0, 0)); !p.Equals(NewVector(7, 5, 5)) {
+			t.Errorf("should be {7, 5, 5} not %v", p)
+		}
+	})
+
+	t.Run("inverse", func(t *testing.T) {
+		m := Translate4(NewVector(1, 2, 3)).Mul(RotateY4(0.7)).Mul(Scale4(NewVector(1, 2, 4)))
+		inv, ok := m.Inverse()
+		if !ok {
+			t.Fatal("should be invertible")
+		}
+
+		id := m.Mul(inv)
+		for i := range id {
+			if math.Abs(id[i]-Identity4()[i]) > 1e-9 {
+				t.Fatalf("m * inverse should be the identity %v", id)
+			}
+		}
+		if tr := m.Transpose().Transpose(); tr != m {
+			t.Error("transposing twice should give the same matrix")
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package vector
+
+import "math"
+
+// a metaball field, the sum over each ball of r² / d² where d is the distance to its centre
+//
+// The field is 1 on the edge of a lone ball and balls that come close enough
+// melt into each other
+type MetaballField struct {
+	Centers []Vector
+	Radii   []float64
+}
+
+// a metaball field with a ball of radii[i] at centers[i]. Centres without a radius are ignored
+func Metaballs(centers []Vector, radii []float64) MetaballField {
+	return MetaballField{Centers: centers, Radii: radii}
+}
+
+// the strength of the field at p, +Inf on a centre
+func (m MetaballField) ValueAt(p Vector) float64 {
+	total := 0.0
+	for i := range min(len(m.Centers), len(m.Radii)) {
+		r := m.Radii[i]
+		total += r * r / MagSq(Sub(p, m.Centers[i]))
+	}
+	return total
+}
+
+// the outlines of the blobs in the xy plane, from MarchingSquares. Closed
+// outlines end with their first point repeated, ones cut off by bounds don't
+func (m MetaballField) Contour(bounds AABB, resolution float64) [][]Vector {
+	// 1/√value is 1 in the same places but finite on the centres, and for a
+	// lone ball it's d/r, which is linear so the edge crossings come out exact
+	field := func(p Vector) float64 {
+		return 1 / math.Sqrt(m.ValueAt(p))
+	}
+	return MarchingSquares(field, bounds, resolution, 1)
+}
@@ -0,0 +1,80 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMetaballs(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		m := Metaballs([]Vector{NewVector(0, 0), NewVector(10, 0)}, []float64{2, 1})
+
+		if v := m.ValueAt(NewVector(0, 2)); math.Abs(v-(1+1.0/104)) > 1e-9 {
+			t.Errorf("should be 1 + 1/104 not %f", v)
+		}
+		if v := m.ValueAt(NewVector(10, 0)); !math.IsInf(v, 1) {
+			t.Errorf("should be +Inf on a centre not %f", v)
+		}
+	})
+
+	t.Run("lone ball outline", func(t *testing.T) {
+		m := Metaballs([]Vector{NewVector(0, 0)}, []float64{3})
+		loops := m.Contour(NewAABB(NewVector(-5, -5), NewVector(5, 5)), 0.25)
+
+		if len(loops) != 1 {
+			t.Fatalf("should be one outline not %d", len(loops))
+		}
+		loop := loops[0]
+		if !loop[0].Equals(loop[len(loop)-1]) {
+			t.Error("outline should be closed")
+		}
+		for _, p := range loop {
+			if d := Mag(p); math.Abs(d-3) > 0.05 {
+				t.Errorf("%v should be on the circle of radius 3, is %f away", p, d)
+			}
+		}
+	})
+
+	t.Run("merging", func(t *testing.T) {
+		bounds := NewAABB(NewVector(-10, -10), NewVector(10, 10))
+		apart := Metaballs([]Vector{NewVector(-5, 0), NewVector(5, 0)}, []float64{2, 2})
+		together := Metaballs([]Vector{NewVector(-2, 0), NewVector(2, 0)}, []float64{2, 2})
+
+		if n := len(apart.Contour(bounds, 0.25)); n != 2 {
+			t.Errorf("balls far apart should have 2 outlines not %d", n)
+		}
+		if n := len(together.Contour(bounds, 0.25)); n != 1 {
+			t.Errorf("balls close together should melt into 1 outline not %d", n)
+		}
+	})
+
+	t.Run("centre on a grid node", func(t *testing.T) {
+		m := Metaballs([]Vector{NewVector(5, 5)}, []float64{0.5})
+		loops := m.Contour(NewAABB(NewVector(0, 0), NewVector(10, 10)), 1)
+
+		if len(loops) != 1 {
+			t.Fatalf("should be one outline not %d", len(loops))
+		}
+		for _, p := range loops[0] {
+			if math.IsNaN(p.X) || math.IsNaN(p.Y) {
+				t.Fatalf("outline has a NaN point: %v", loops[0])
+			}
+			if d := Dist(p, NewVector(5, 5)); math.Abs(d-0.5) > 1e-9 {
+				t.Errorf("%v should be 0.5 from the centre not %f", p, d)
+			}
+		}
+	})
+
+	t.Run("cut off by the bounds", func(t *testing.T) {
+		m := Metaballs([]Vector{NewVector(0, 0)}, []float64{3})
+		lines := m.Contour(NewAABB(NewVector(0, -5), NewVector(5, 5)), 0.25)
+
+		if len(lines) != 1 {
+			t.Fatalf("should be one line not %d", len(lines))
+		}
+		line := lines[0]
+		if line[0].Equals(line[len(line)-1]) {
+			t.Error("a cut off outline shouldn't be closed")
+		}
+	})
+}
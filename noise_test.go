@@ -0,0 +1,24 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNoise(t *testing.T) {
+	for _, p := range [][3]float64{{0, 0, 0}, {1.5, 2.25, 0.1}, {-7.3, 4.1, 3}, {100.5, -0.5, 12.75}} {
+		n := Noise(p[0], p[1], p[2])
+		if n < 0 || n > 1 {
+			t.Errorf("noise at %v should be between 0 and 1, got %f", p, n)
+		}
+		if n != Noise(p[0], p[1], p[2]) {
+			t.Errorf("noise at %v should be repeatable", p)
+		}
+		if d := math.Abs(Noise(p[0]+0.001, p[1], p[2]) - n); d > 0.01 {
+			t.Errorf("noise at %v should be smooth, jumped by %f", p, d)
+		}
+	}
+	if Noise(1, 2, 3) != 0.5 {
+		t.Errorf("noise on the lattice should be 0.5 not %f", Noise(1, 2, 3))
+	}
+}
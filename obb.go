@@ -0,0 +1,58 @@
+package vector
+
+// an oriented (rotated) box in the xy plane
+//
+// HalfExtents holds half the width and height before rotation. Angle uses the
+// same direction as Rotate
+type OBB struct {
+	Center      Vector
+	HalfExtents Vector
+	Angle       float64
+}
+
+// the point in the box's own unrotated frame, relative to its centre
+func (b OBB) toLocal(p Vector) Vector {
+	return Rotate(Sub(p, b.Center), -b.Angle)
+}
+
+// check if the point is inside (or on the edge of) the box
+func (b OBB) Contains(p Vector) bool {
+	l := b.toLocal(p)
+	return l.X >= -b.HalfExtents.X && l.X <= b.HalfExtents.X &&
+		l.Y >= -b.HalfExtents.Y && l.Y <= b.HalfExtents.Y
+}
+
+// the four corners of the box, going round the box
+func (b OBB) Corners() []Vector {
+	hx, hy := b.HalfExtents.X, b.HalfExtents.Y
+	local := []Vector{
+		NewVector(-hx, -hy),
+		NewVector(hx, -hy),
+		NewVector(hx, hy),
+		NewVector(-hx, hy),
+	}
+
+	corners := make([]Vector, 4)
+	for i, c := range local {
+		corners[i] = Add(b.Center, Rotate(c, b.Angle))
+	}
+	return corners
+}
+
+// check if the two boxes overlap
+func (b OBB) IntersectsOBB(other OBB) bool {
+	_, hit := SATCollide(b.Corners(), other.Corners())
+	return hit
+}
+
+// check if the box overlaps the xy extent of an axis aligned box
+func (b OBB) IntersectsAABB(box AABB) bool {
+	corners := Polygon{
+		NewVector(box.Min.X, box.Min.Y),
+		NewVector(box.Max.X, box.Min.Y),
+		NewVector(box.Max.X, box.Max.Y),
+		NewVector(box.Min.X, box.Max.Y),
+	}
+	_, hit := SATCollide(b.Corners(), corners)
+	return hit
+}
@@ -0,0 +1,52 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOBB(t *testing.T) {
+	b := OBB{NewVector(0, 0), NewVector(2, 0.5), math.Pi / 4}
+
+	t.Run("contains", func(t *testing.T) {
+		if !b.Contains(NewVector(1, -1)) {
+			t.Error("should contain a point along its long axis")
+		}
+		if b.Contains(NewVector(1.5, 0)) {
+			t.Error("should not contain a point off its axis")
+		}
+	})
+
+	t.Run("corners", func(t *testing.T) {
+		straight := OBB{NewVector(1, 1), NewVector(2, 1), 0}
+		c := straight.Corners()
+
+		if !c[0].Equals(NewVector(-1, 0)) || !c[2].Equals(NewVector(3, 2)) {
+			t.Errorf("corners wrong %v", c)
+		}
+		for _, p := range b.Corners() {
+			if !compare(t, p.Mag(), math.Hypot(2, 0.5)) {
+				t.Errorf("corner %v should be %f from the centre", p, math.Hypot(2, 0.5))
+			}
+		}
+	})
+
+	t.Run("intersects", func(t *testing.T) {
+		other := OBB{NewVector(2, 2), NewVector(0.5, 0.5), 0}
+		if b.IntersectsOBB(other) {
+			t.Error("diagonal box should miss the box on the other diagonal")
+		}
+
+		other.Center = NewVector(1.2, -1.2)
+		if !b.IntersectsOBB(other) {
+			t.Error("should hit a box on its long axis")
+		}
+
+		if !b.IntersectsAABB(AABB{NewVector(1, -2), NewVector(2, -1)}) {
+			t.Error("should hit the aabb")
+		}
+		if b.IntersectsAABB(AABB{NewVector(1, 1), NewVector(2, 2)}) {
+			t.Error("should miss the aabb")
+		}
+	})
+}
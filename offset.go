@@ -0,0 +1,64 @@
+package vector
+
+// how OffsetPolygon joins the offset edges at the outside of a corner
+type OffsetJoin int
+
+const (
+	// extend the edges until they meet
+	JoinMiter OffsetJoin = iota
+	// cut the corner off with a straight edge
+	JoinBevel
+)
+
+// miters longer than this many times the offset distance are bevelled instead
+const miterLimit = 4
+
+// the polygon moved outwards by distance (inwards if it's negative), eg for a
+// collision margin or an outline
+//
+// Outwards is the same whichever way round the points go. The inside of
+// corners always meets at a point; the outside is joined as join says. There's
+// no clean up, so offsetting inwards by more than the polygon is thick, or
+// outwards round deep concave notches, can leave the result crossing itself
+func OffsetPolygon(poly []Vector, distance float64, join OffsetJoin) []Vector {
+	n := len(poly)
+	if n < 3 || distance == 0 {
+		return append([]Vector{}, poly...)
+	}
+
+	winding := 1.0
+	if Polygon(poly).signedArea() < 0 {
+		winding = -1
+	}
+	// the outward normal of the edge leaving point i
+	normal := func(i int) Vector {
+		e := Sub(poly[(i+1)%n], poly[i])
+		if e.MagSq() == 0 {
+			return Vector{}
+		}
+		return Normalise(NewVector(e.Y*winding, -e.X*winding))
+	}
+
+	out := make([]Vector, 0, n)
+	for i, p := range poly {
+		n0, n1 := normal((i+n-1)%n), normal(i)
+		outside := cross2(poly[(i+n-1)%n], p, poly[(i+1)%n])*winding*distance > 0
+
+		m := Add(n0, n1)
+		cosHalf := 0.0
+		if m.MagSq() > 0 {
+			m.Normalise()
+			cosHalf = DotProduct(m, n0)
+		}
+
+		switch {
+		case cosHalf > 1e-9 && (!outside || join == JoinMiter && 1/cosHalf <= miterLimit):
+			out = append(out, Add(p, Mult(m, distance/cosHalf)))
+		case n0.MagSq() == 0 || n1.MagSq() == 0:
+			out = append(out, Add(p, Mult(Add(n0, n1), distance)))
+		default:
+			out = append(out, Add(p, Mult(n0, distance)), Add(p, Mult(n1, distance)))
+		}
+	}
+	return out
+}
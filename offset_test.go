@@ -0,0 +1,70 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOffsetPolygon(t *testing.T) {
+	square := []Vector{NewVector(0, 0), NewVector(10, 0), NewVector(10, 10), NewVector(0, 10)}
+
+	t.Run("miter inflates the square", func(t *testing.T) {
+		out := OffsetPolygon(square, 1, JoinMiter)
+
+		expected := []Vector{NewVector(-1, -1), NewVector(11, -1), NewVector(11, 11), NewVector(-1, 11)}
+		if len(out) != 4 {
+			t.Fatalf("should be %v not %v", expected, out)
+		}
+		for i := range expected {
+			if !out[i].Equals(expected[i]) {
+				t.Errorf("corner %d should be %v not %v", i, expected[i], out[i])
+			}
+		}
+	})
+
+	t.Run("either winding goes outwards", func(t *testing.T) {
+		reversed := []Vector{square[3], square[2], square[1], square[0]}
+		out := OffsetPolygon(reversed, 1, JoinMiter)
+
+		if a := Polygon(out).Area(); math.Abs(a-144) > 1e-9 {
+			t.Errorf("area should be 144 not %f", a)
+		}
+	})
+
+	t.Run("bevel cuts the corners", func(t *testing.T) {
+		out := OffsetPolygon(square, 1, JoinBevel)
+
+		if len(out) != 8 {
+			t.Fatalf("should have 8 points not %d", len(out))
+		}
+		if !out[0].Equals(NewVector(-1, 0)) || !out[1].Equals(NewVector(0, -1)) {
+			t.Errorf("first corner should be (-1, 0), (0, -1) not %v, %v", out[0], out[1])
+		}
+		// 144 less four corner triangles of area 1/2
+		if a := Polygon(out).Area(); math.Abs(a-142) > 1e-9 {
+			t.Errorf("area should be 142 not %f", a)
+		}
+	})
+
+	t.Run("deflate meets inside corners at a point", func(t *testing.T) {
+		out := OffsetPolygon(square, -2, JoinBevel)
+
+		if len(out) != 4 || !out[0].Equals(NewVector(2, 2)) {
+			t.Errorf("should be the square from (2, 2) to (8, 8), got %v", out)
+		}
+	})
+
+	t.Run("sharp corners fall back to bevel", func(t *testing.T) {
+		spike := []Vector{NewVector(0, 0), NewVector(10, 0), NewVector(0, 1)}
+		out := OffsetPolygon(spike, 1, JoinMiter)
+
+		for _, p := range out {
+			if Dist(p, NewVector(5, 0.5)) > 20 {
+				t.Errorf("%v is too far out for a limited miter", p)
+			}
+		}
+		if len(out) != 4 {
+			t.Errorf("the sharp corner should be bevelled, got %v", out)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package vector
+
+import "math"
+
+// the gravitational acceleration on body towards center, for a central mass
+// with gravitational parameter mu (G times its mass)
+//
+// Returns zero when body is at center
+func Attract(body, center Vector, mu float64) Vector {
+	r := Sub(center, body)
+	d2 := r.MagSq()
+	if d2 == 0 {
+		return Vector{}
+	}
+	return Mult(r, mu/(d2*math.Sqrt(d2)))
+}
+
+// the velocity body needs for a circular orbit about center in the xy plane
+//
+// The orbit goes the way positive angles turn (see Rotate)
+func CircularOrbitVelocity(center, body Vector, mu float64) Vector {
+	r := Sub(body, center)
+	d := r.Mag()
+	if d == 0 {
+		return Vector{}
+	}
+	return SetMag(Rotate(r, math.Pi/2), math.Sqrt(mu/d))
+}
+
+// the shape of an orbit
+//
+// SemiMajorAxis is negative for hyperbolic orbits and infinite for parabolic
+// ones. Eccentricity points at the closest approach and its length is the
+// eccentricity: 0 for a circle, under 1 for an ellipse
+type Orbit struct {
+	SemiMajorAxis float64
+	Eccentricity  Vector
+	Mu            float64
+}
+
+// the orbit of a body at pos with velocity vel, both relative to the central mass
+func OrbitalElements(pos, vel Vector, mu float64) Orbit {
+	r := pos.Mag()
+	v2 := vel.MagSq()
+
+	// e = ((v² - mu/r) r - (r·v) v) / mu
+	e := Sub(Mult(pos, v2-mu/r), Mult(vel, DotProduct(pos, vel)))
+	e.Div(mu)
+
+	energy := v2/2 - mu/r
+	a := math.Inf(1)
+	if energy != 0 {
+		a = -mu / (2 * energy)
+	}
+	return Orbit{a, e, mu}
+}
+
+// the time for one orbit, or +Inf if the body escapes
+func (o Orbit) Period() float64 {
+	if o.SemiMajorAxis <= 0 || math.IsInf(o.SemiMajorAxis, 0) {
+		return math.Inf(1)
+	}
+	return 2 * math.Pi * math.Sqrt(o.SemiMajorAxis*o.SemiMajorAxis*o.SemiMajorAxis/o.Mu)
+}
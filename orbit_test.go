@@ -0,0 +1,64 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAttract(t *testing.T) {
+	a := Attract(NewVector(3, 4), NewVector(0, 0), 50)
+
+	if !a.Equals(NewVector(-1.2, -1.6)) {
+		t.Errorf("should be (-1.2, -1.6) not %v", a)
+	}
+	if a := Attract(NewVector(1, 1), NewVector(1, 1), 50); !a.Equals(Vector{}) {
+		t.Errorf("should be zero at the centre not %v", a)
+	}
+}
+
+func TestCircularOrbit(t *testing.T) {
+	center := NewVector(10, 10)
+	pos := NewVector(20, 10)
+	mu := 400.0
+	vel := CircularOrbitVelocity(center, pos, mu)
+
+	if math.Abs(vel.Mag()-math.Sqrt(40)) > 1e-9 || math.Abs(DotProduct(vel, Sub(pos, center))) > 1e-9 {
+		t.Errorf("should be tangent with speed √40, got %v", vel)
+	}
+
+	o := OrbitalElements(Sub(pos, center), vel, mu)
+	if math.Abs(o.SemiMajorAxis-10) > 1e-9 || o.Eccentricity.Mag() > 1e-9 {
+		t.Errorf("should be a circle of radius 10, got %+v", o)
+	}
+
+	// fly it round once and check it stays on the circle
+	dt := o.Period() / 20000
+	for range 20000 {
+		vel.Add(Mult(Attract(pos, center, mu), dt))
+		pos.Add(Mult(vel, dt))
+	}
+	if d := Dist(pos, center); math.Abs(d-10) > 0.01 {
+		t.Errorf("should stay 10 away not %f", d)
+	}
+	if d := Dist(pos, NewVector(20, 10)); d > 0.1 {
+		t.Errorf("should be back at the start after a period, %f away", d)
+	}
+}
+
+func TestOrbitalElements(t *testing.T) {
+	mu := 1.0
+	// at perihelion of an ellipse with a = 2, e = 0.5 the distance is 1 and v² = mu(2/r - 1/a)
+	o := OrbitalElements(NewVector(1, 0), NewVector(0, math.Sqrt(1.5)), mu)
+
+	if math.Abs(o.SemiMajorAxis-2) > 1e-9 {
+		t.Errorf("semi-major axis should be 2 not %f", o.SemiMajorAxis)
+	}
+	if !o.Eccentricity.Equals(NewVector(0.5, 0)) {
+		t.Errorf("eccentricity should be (0.5, 0) not %v", o.Eccentricity)
+	}
+
+	escape := OrbitalElements(NewVector(1, 0), NewVector(0, 2), mu)
+	if escape.SemiMajorAxis >= 0 || !math.IsInf(escape.Period(), 1) {
+		t.Errorf("should be hyperbolic, got %+v", escape)
+	}
+}
@@ -0,0 +1,74 @@
+package vector
+
+// n points evenly spaced along the outline. With closed the outline joins
+// back from the last point to the first
+func ResampleOutline(points []Vector, closed bool, n int) []Vector {
+	if n <= 0 || len(points) == 0 {
+		return []Vector{}
+	}
+
+	path := Path{Points: points}
+	if closed {
+		path.Points = append(append([]Vector{}, points...), points[0])
+	}
+
+	length := path.Length()
+	out := make([]Vector, n)
+	for i := range out {
+		var d float64
+		switch {
+		case closed:
+			// the end is the start again so don't repeat it
+			d = length * float64(i) / float64(n)
+		case n > 1:
+			d = length * float64(i) / float64(n-1)
+		}
+		out[i] = path.PointAt(d)
+	}
+	return out
+}
+
+// each point of the outline with its unit normal
+//
+// The normal at a point is the average of the normals of the edges either
+// side of it. For closed outlines the normals point outwards whichever way
+// round the points go. Open outlines have their normals on the right of the
+// direction of travel in y-up terms (the left on a y-down screen)
+func OutlineWithNormals(points []Vector, closed bool) []struct{ P, N Vector } {
+	out := make([]struct{ P, N Vector }, len(points))
+	n := len(points)
+	if n == 0 {
+		return out
+	}
+
+	flip := 1.0
+	if closed && Polygon(points).signedArea() < 0 {
+		flip = -1.0
+	}
+
+	edgeNormal := func(a, b Vector) Vector {
+		d := Sub(b, a)
+		if d.MagSq() == 0 {
+			return Vector{}
+		}
+		return Mult(Normalise(NewVector(d.Y, -d.X)), flip)
+	}
+
+	for i, p := range points {
+		var sum Vector
+		if i > 0 || closed {
+			sum.Add(edgeNormal(points[(i-1+n)%n], p))
+		}
+		if i < n-1 || closed {
+			sum.Add(edgeNormal(p, points[(i+1)%n]))
+		}
+
+		if sum.MagSq() > 0 {
+			sum.Normalise()
+		}
+		out[i].P = p
+		out[i].N = sum
+	}
+
+	return out
+}
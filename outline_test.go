@@ -0,0 +1,54 @@
+package vector
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestResampleOutline(t *testing.T) {
+	sq := square(0, 0, 2)
+
+	closed := ResampleOutline(sq, true, 8)
+	if len(closed) != 8 || !closed[1].Equals(NewVector(1, 0)) || !closed[7].Equals(NewVector(0, 1)) {
+		t.Errorf("closed resample wrong %v", closed)
+	}
+
+	open := ResampleOutline(sq, false, 4)
+	if !open[0].Equals(sq[0]) || !open[3].Equals(sq[3]) || !open[1].Equals(NewVector(2, 0)) {
+		t.Errorf("open resample wrong %v", open)
+	}
+}
+
+func TestOutlineWithNormals(t *testing.T) {
+	s := math.Sqrt2 / 2
+
+	t.Run("closed normals point outwards either way round", func(t *testing.T) {
+		sq := square(0, 0, 2)
+		expected := []Vector{NewVector(-s, -s), NewVector(s, -s), NewVector(s, s), NewVector(-s, s)}
+
+		for i, pn := range OutlineWithNormals(sq, true) {
+			if !pn.P.Equals(sq[i]) || !pn.N.Equals(expected[i]) {
+				t.Errorf("point %d normal should be %v not %v", i, expected[i], pn.N)
+			}
+		}
+
+		slices.Reverse(sq)
+		slices.Reverse(expected)
+		for i, pn := range OutlineWithNormals(sq, true) {
+			if !pn.N.Equals(expected[i]) {
+				t.Errorf("reversed point %d normal should be %v not %v", i, expected[i], pn.N)
+			}
+		}
+	})
+
+	t.Run("open ends use their only edge", func(t *testing.T) {
+		line := []Vector{NewVector(0, 0), NewVector(1, 0), NewVector(2, 0)}
+
+		for i, pn := range OutlineWithNormals(line, false) {
+			if !pn.N.Equals(NewVector(0, -1)) {
+				t.Errorf("point %d normal should be {0, -1} not %v", i, pn.N)
+			}
+		}
+	})
+}
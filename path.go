@@ -0,0 +1,77 @@
+package vector
+
+// a polyline with a width, eg a road for agents to follow
+type Path struct {
+	Points []Vector
+	Radius float64
+}
+
+// creates a path through the points
+func NewPath(radius float64, points ...Vector) *Path {
+	return &Path{Points: points, Radius: radius}
+}
+
+// the total length of the path
+func (p *Path) Length() float64 {
+	return PolylineLength(p.Points)
+}
+
+// the point distance d along the path. d is clamped to the ends of the path
+func (p *Path) PointAt(d float64) Vector {
+	if len(p.Points) == 0 {
+		return Vector{}
+	}
+
+	for i := 1; i < len(p.Points); i++ {
+		a, b := p.Points[i-1], p.Points[i]
+		l := Dist(a, b)
+		if d <= l {
+			if l == 0 || d <= 0 {
+				return a
+			}
+			return Lerp(a, b, d/l)
+		}
+		d -= l
+	}
+
+	return p.Points[len(p.Points)-1]
+}
+
+// the closest point on the path to q and how far along the path it is
+func (p *Path) Closest(q Vector) (point Vector, along float64) {
+	if len(p.Points) == 0 {
+		return Vector{}, 0
+	}
+
+	point = p.Points[0]
+	best := Dist(q, point)
+	travelled := 0.0
+
+	for i := 1; i < len(p.Points); i++ {
+		a, b := p.Points[i-1], p.Points[i]
+		c, t := closestOnSegment(a, b, q)
+		l := Dist(a, b)
+
+		if d := Dist(q, c); d < best {
+			best = d
+			point = c
+			along = travelled + t*l
+		}
+		travelled += l
+	}
+
+	return point, along
+}
+
+// the closest point to q on the segment a-b and how far along it is (0 to 1)
+func closestOnSegment(a, b, q Vector) (Vector, float64) {
+	ab := Sub(b, a)
+	l := ab.MagSq()
+	if l == 0 {
+		return a, 0
+	}
+
+	t := DotProduct(Sub(q, a), ab) / l
+	t = max(0, min(1, t))
+	return Add(a, Mult(ab, t)), t
+}
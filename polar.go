@@ -0,0 +1,75 @@
+package vector
+
+import "math"
+
+// a 2d point in polar form: distance R from the origin at angle Theta
+//
+// Theta goes the same way as FromAngle and Direction
+type Polar struct {
+	R, Theta float64
+}
+
+// creates a Polar
+func NewPolar(r, theta float64) Polar {
+	return Polar{r, theta}
+}
+
+// the polar form of the 2d vector v, with Theta in (-π, π]. Z is ignored
+func PolarFromVector(v Vector) Polar {
+	return Polar{math.Hypot(v.X, v.Y), heading(v)}
+}
+
+// sets p to the polar form of v
+func (p *Polar) FromVector(v Vector) *Polar {
+	*p = PolarFromVector(v)
+	return p
+}
+
+// the point as a Vector
+func (p Polar) ToVector() Vector {
+	return NewVector(p.R*math.Cos(-p.Theta), p.R*math.Sin(-p.Theta))
+}
+
+// the point r from the origin at azimuth theta round the z axis and
+// inclination phi down from +z
+//
+// theta goes the same way as FromAngle, so with phi = π/2 it's the same as
+// FromAngle(theta, r)
+func FromSpherical(r, theta, phi float64) Vector {
+	s := math.Sin(phi)
+	return NewVector(r*s*math.Cos(-theta), r*s*math.Sin(-theta), r*math.Cos(phi))
+}
+
+// the spherical coordinates of v, the inverse of FromSpherical. theta is in
+// (-π, π] and phi in [0, π]
+func ToSpherical(v Vector) (r, theta, phi float64) {
+	r = v.Mag()
+	if r == 0 {
+		return 0, 0, 0
+	}
+	return r, heading(v), math.Acos(max(-1, min(1, v.Z/r)))
+}
+
+// the spherical coordinates of the vector
+func (v Vector) ToSpherical() (r, theta, phi float64) {
+	return ToSpherical(v)
+}
+
+// the point r from the z axis at angle theta round it and height z
+//
+// theta goes the same way as FromAngle, so with z = 0 it's the same as FromAngle(theta, r)
+func FromCylindrical(r, theta, z float64) Vector {
+	v := FromAngle(theta, r)
+	v.Z = z
+	return v
+}
+
+// the cylindrical coordinates of v, the inverse of FromCylindrical. theta is in (-π, π]
+func ToCylindrical(v Vector) (r, theta, z float64) {
+	return math.Hypot(v.X, v.Y), heading(v), v.Z
+}
+
+// the cylindrical coordinates of the vector
+func (v Vector) ToCylindrical() (r, theta, z float64) {
+	return ToCylindrical(v)
+}
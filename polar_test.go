@@ -0,0 +1,83 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPolar(t *testing.T) {
+	t.Run("to vector matches FromAngle", func(t *testing.T) {
+		p := NewPolar(3, 0.7)
+
+		if v := p.ToVector(); !v.Equals(FromAngle(0.7, 3)) {
+			t.Errorf("should be %v not %v", FromAngle(0.7, 3), v)
+		}
+		if v := NewPolar(2, math.Pi/2).ToVector(); !v.Equals(NewVector(0, -2)) {
+			t.Errorf("a quarter turn should point along -y, got %v", v)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		v := NewVector(-3, 4)
+		var p Polar
+		p.FromVector(v)
+
+		if math.Abs(p.R-5) > 1e-9 || math.Abs(p.Theta-Direction(v).Radians()) > 1e-9 {
+			t.Errorf("should be r 5 at %f not %+v", Direction(v).Radians(), p)
+		}
+		if back := p.ToVector(); !back.Equals(v) {
+			t.Errorf("should come back to %v not %v", v, back)
+		}
+	})
+}
+
+func TestSpherical(t *testing.T) {
+	t.Run("equator matches FromAngle", func(t *testing.T) {
+		if v := FromSpherical(2, 0.9, math.Pi/2); !v.Equals(FromAngle(0.9, 2)) {
+			t.Errorf("should be %v not %v", FromAngle(0.9, 2), v)
+		}
+		if v := FromSpherical(3, 1.2, 0); !v.Equals(NewVector(0, 0, 3)) {
+			t.Errorf("phi 0 should be straight up +z, got %v", v)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		v := NewVector(1, -2, -2)
+		r, theta, phi := v.ToSpherical()
+
+		if math.Abs(r-3) > 1e-9 || phi < math.Pi/2 {
+			t.Errorf("should be radius 3 below the equator, got %f %f %f", r, theta, phi)
+		}
+		if back := FromSpherical(r, theta, phi); !back.Equals(v) {
+			t.Errorf("should come back to %v not %v", v, back)
+		}
+	})
+}
+
+func TestCylindrical(t *testing.T) {
+	t.Run("helix", func(t *testing.T) {
+		for i := range 8 {
+			a := float64(i) * math.Pi / 4
+			p := FromCylindrical(2, a, a/(2*math.Pi))
+
+			if math.Abs(math.Hypot(p.X, p.Y)-2) > 1e-9 {
+				t.Errorf("%v should be 2 from the axis", p)
+			}
+			if math.Abs(p.Z-a/(2*math.Pi)) > 1e-9 {
+				t.Errorf("%v should be at height %f", p, a/(2*math.Pi))
+			}
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		v := NewVector(-3, -4, 7)
+		r, theta, z := v.ToCylindrical()
+
+		if r != 5 || z != 7 {
+			t.Errorf("should be r 5 and z 7 not %f %f", r, z)
+		}
+		if back := FromCylindrical(r, theta, z); !back.Equals(v) {
+			t.Errorf("should come back to %v not %v", v, back)
+		}
+	})
+}
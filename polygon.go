@@ -0,0 +1,89 @@
+package vector
+
+import "math"
+
+// a closed polygon in the xy plane. The last point joins back to the first
+type Polygon []Vector
+
+// the area of the polygon
+func (p Polygon) Area() float64 {
+	return math.Abs(p.signedArea())
+}
+
+// positive when the points go anticlockwise in y-up terms (clockwise on a y-down screen)
+func (p Polygon) signedArea() float64 {
+	a := 0.0
+	for i := range p {
+		j := (i + 1) % len(p)
+		a += p[i].X*p[j].Y - p[j].X*p[i].Y
+	}
+	return a / 2
+}
+
+// check if the point is inside the polygon (even-odd rule)
+func (p Polygon) Contains(q Vector) bool {
+	inside := false
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		a, b := p[i], p[j]
+		if (a.Y > q.Y) != (b.Y > q.Y) {
+			x := a.X + (q.Y-a.Y)/(b.Y-a.Y)*(b.X-a.X)
+			if q.X < x {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// checks two convex polygons for overlap with the Separating Axis Theorem
+//
+// If they collide mtv is the smallest translation that moves a out of b
+func SATCollide(a, b Polygon) (mtv Vector, colliding bool) {
+	if len(a) == 0 || len(b) == 0 {
+		return Vector{}, false
+	}
+
+	best := math.Inf(1)
+	var axis Vector
+
+	for _, poly := range []Polygon{a, b} {
+		for i := range poly {
+			edge := Sub(poly[(i+1)%len(poly)], poly[i])
+			if edge.MagSq() == 0 {
+				continue
+			}
+			n := Normalise(NewVector(-edge.Y, edge.X))
+
+			minA, maxA := project(a, n)
+			minB, maxB := project(b, n)
+
+			overlap := min(maxA, maxB) - max(minA, minB)
+			if overlap <= 0 {
+				return Vector{}, false
+			}
+
+			if overlap < best {
+				best = overlap
+				axis = n
+			}
+		}
+	}
+
+	// point the mtv from b towards a
+	if DotProduct(Sub(centroid(a), centroid(b)), axis) < 0 {
+		axis.Mult(-1)
+	}
+
+	return Mult(axis, best), true
+}
+
+// the range of the polygon's shadow on the axis
+func project(p Polygon, axis Vector) (lo, hi float64) {
+	lo, hi = math.Inf(1), math.Inf(-1)
+	for _, v := range p {
+		d := v.DotProduct(axis)
+		lo = min(lo, d)
+		hi = max(hi, d)
+	}
+	return
+}
@@ -0,0 +1,51 @@
+package vector
+
+import "testing"
+
+func TestPolygon(t *testing.T) {
+	p := Polygon(square(0, 0, 2))
+
+	if a := p.Area(); a != 4 {
+		t.Errorf("area should be 4 not %f", a)
+	}
+	if !p.Contains(NewVector(1, 1)) || p.Contains(NewVector(3, 1)) {
+		t.Error("contains is wrong")
+	}
+}
+
+func TestSATCollide(t *testing.T) {
+	a := Polygon(square(0, 0, 2))
+
+	t.Run("overlapping squares", func(t *testing.T) {
+		b := Polygon(square(1.5, 0.5, 2))
+
+		mtv, hit := SATCollide(a, b)
+		if !hit {
+			t.Fatal("squares should collide")
+		}
+		if !mtv.Equals(NewVector(-0.5, 0)) {
+			t.Errorf("mtv should push a left by 0.5 not %v", mtv)
+		}
+	})
+
+	t.Run("rotated square", func(t *testing.T) {
+		diamond := Polygon{NewVector(3, 1), NewVector(4, 2), NewVector(3, 3), NewVector(2, 2)}
+
+		if _, hit := SATCollide(a, diamond); hit {
+			t.Error("should not collide")
+		}
+
+		diamond = Polygon{NewVector(2.5, 1), NewVector(3.5, 2), NewVector(2.5, 3), NewVector(1.5, 2)}
+		mtv, hit := SATCollide(a, diamond)
+		if !hit {
+			t.Fatal("should collide")
+		}
+		moved := make(Polygon, len(a))
+		for i := range a {
+			moved[i] = Add(a[i], mtv)
+		}
+		if _, still := SATCollide(moved, diamond); still {
+			t.Errorf("should be separated after moving by %v", mtv)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package vector
+
+import "math"
+
+// the total length of the polyline through the points
+func PolylineLength(path []Vector) float64 {
+	l := 0.0
+	for i := 1; i < len(path); i++ {
+		l += Dist(path[i-1], path[i])
+	}
+	return l
+}
+
+// the path with extra points added so no segment is longer than maxSegLen
+//
+// The original points are kept and each segment is split into equal pieces.
+// A maxSegLen of 0 or less returns a copy of the path
+func Subdivide(path []Vector, maxSegLen float64) []Vector {
+	if len(path) == 0 {
+		return []Vector{}
+	}
+
+	out := []Vector{path[0]}
+	for i := 1; i < len(path); i++ {
+		a, b := path[i-1], path[i]
+		pieces := 1
+		if maxSegLen > 0 {
+			pieces = max(1, int(math.Ceil(Dist(a, b)/maxSegLen)))
+		}
+		for j := 1; j <= pieces; j++ {
+			out = append(out, Lerp(a, b, float64(j)/float64(pieces)))
+		}
+	}
+	return out
+}
+
+// the part of the path from fromDist to toDist along it, eg to draw a stroke
+// that's partly finished
+//
+// The distances are clamped to the ends of the path. The result starts and
+// ends exactly at those distances and is empty if toDist is before fromDist
+func Trim(path []Vector, fromDist, toDist float64) []Vector {
+	if len(path) == 0 || toDist < fromDist {
+		return []Vector{}
+	}
+
+	p := Path{Points: path}
+	fromDist = max(0, fromDist)
+	toDist = min(p.Length(), toDist)
+	if toDist < fromDist {
+		return []Vector{}
+	}
+
+	out := []Vector{p.PointAt(fromDist)}
+	travelled := 0.0
+	for i := 1; i < len(path); i++ {
+		travelled += Dist(path[i-1], path[i])
+		if travelled > fromDist && travelled < toDist {
+			out = append(out, path[i])
+		}
+	}
+	return append(out, p.PointAt(toDist))
+}
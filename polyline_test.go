@@ -0,0 +1,64 @@
+package vector
+
+import "testing"
+
+var lShape = []Vector{NewVector(0, 0), NewVector(10, 0), NewVector(10, 5)}
+
+func TestPolylineLength(t *testing.T) {
+	if l := PolylineLength(lShape); l != 15 {
+		t.Errorf("should be 15 not %f", l)
+	}
+	if l := PolylineLength(lShape[:1]); l != 0 {
+		t.Errorf("a single point should be 0 not %f", l)
+	}
+}
+
+func TestSubdivide(t *testing.T) {
+	out := Subdivide(lShape, 4)
+
+	// 10 splits into 3 and 5 into 2
+	if len(out) != 6 {
+		t.Fatalf("should have 6 points not %d: %v", len(out), out)
+	}
+	for i := 1; i < len(out); i++ {
+		if Dist(out[i-1], out[i]) > 4+1e-9 {
+			t.Errorf("segment %d is too long: %v to %v", i, out[i-1], out[i])
+		}
+	}
+	if !out[3].Equals(lShape[1]) || !out[5].Equals(lShape[2]) {
+		t.Errorf("should keep the original points, got %v", out)
+	}
+	if l := PolylineLength(out); l != 15 {
+		t.Errorf("length should stay 15 not %f", l)
+	}
+}
+
+func TestTrim(t *testing.T) {
+	t.Run("across a corner", func(t *testing.T) {
+		out := Trim(lShape, 5, 12)
+
+		expected := []Vector{NewVector(5, 0), NewVector(10, 0), NewVector(10, 2)}
+		if len(out) != len(expected) {
+			t.Fatalf("should be %v not %v", expected, out)
+		}
+		for i := range expected {
+			if !out[i].Equals(expected[i]) {
+				t.Errorf("point %d should be %v not %v", i, expected[i], out[i])
+			}
+		}
+	})
+
+	t.Run("clamped to the ends", func(t *testing.T) {
+		out := Trim(lShape, -3, 100)
+
+		if len(out) != 3 || !out[0].Equals(lShape[0]) || !out[2].Equals(lShape[2]) {
+			t.Errorf("should be the whole path, got %v", out)
+		}
+	})
+
+	t.Run("backwards is empty", func(t *testing.T) {
+		if out := Trim(lShape, 8, 2); len(out) != 0 {
+			t.Errorf("should be empty not %v", out)
+		}
+	})
+}
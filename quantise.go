@@ -0,0 +1,61 @@
+package vector
+
+import "math"
+
+// the most bits per axis that fit three axes in a uint64
+const MaxQuantiseBits = 21
+
+// packs v into bitsPerAxis bits for each of x, y and z, eg for sending
+// positions over a network. v is clamped into bounds first
+//
+// bitsPerAxis is kept between 1 and MaxQuantiseBits. Dequantise gets back to
+// within QuantiseError of v
+func Quantise(v Vector, bounds AABB, bitsPerAxis int) uint64 {
+	bits := quantiseBits(bitsPerAxis)
+	steps := float64(uint64(1)<<bits - 1)
+	v = Clamp(v, bounds.Min, bounds.Max)
+
+	var q uint64
+	for i, a := range [3][3]float64{
+		{v.X, bounds.Min.X, bounds.Max.X},
+		{v.Y, bounds.Min.Y, bounds.Max.Y},
+		{v.Z, bounds.Min.Z, bounds.Max.Z},
+	} {
+		size := a[2] - a[1]
+		if size <= 0 {
+			continue
+		}
+		n := uint64(math.Round((a[0] - a[1]) / size * steps))
+		q |= n << (uint(i) * bits)
+	}
+	return q
+}
+
+// unpacks a position packed by Quantise with the same bounds and bitsPerAxis
+func Dequantise(q uint64, bounds AABB, bitsPerAxis int) Vector {
+	bits := quantiseBits(bitsPerAxis)
+	mask := uint64(1)<<bits - 1
+	steps := float64(mask)
+
+	var out [3]float64
+	for i, a := range [3][2]float64{
+		{bounds.Min.X, bounds.Max.X},
+		{bounds.Min.Y, bounds.Max.Y},
+		{bounds.Min.Z, bounds.Max.Z},
+	} {
+		n := (q >> (uint(i) * bits)) & mask
+		out[i] = a[0] + float64(n)/steps*(a[1]-a[0])
+	}
+	return NewVector(out[0], out[1], out[2])
+}
+
+// the most each component can be out by after a Quantise and Dequantise round
+// trip: half a step, which is the size of bounds over 2^bitsPerAxis - 1, over 2
+func QuantiseError(bounds AABB, bitsPerAxis int) Vector {
+	steps := float64(uint64(1)<<quantiseBits(bitsPerAxis) - 1)
+	return Div(bounds.Size(), 2*steps)
+}
+
+func quantiseBits(bitsPerAxis int) uint {
+	return uint(max(1, min(bitsPerAxis, MaxQuantiseBits)))
+}
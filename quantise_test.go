@@ -0,0 +1,39 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuantise(t *testing.T) {
+	bounds := NewAABB(NewVector(-100, -100, 0), NewVector(100, 100, 10))
+
+	t.Run("round trip within the error", func(t *testing.T) {
+		for _, bits := range []int{4, 10, 16, 21} {
+			limit := QuantiseError(bounds, bits)
+			for _, v := range []Vector{NewVector(0, 0, 0), NewVector(-100, 100, 10), NewVector(12.345, -67.89, 3.21)} {
+				back := Dequantise(Quantise(v, bounds, bits), bounds, bits)
+				d := Abs(Sub(back, v))
+				if d.X > limit.X+1e-9 || d.Y > limit.Y+1e-9 || d.Z > limit.Z+1e-9 {
+					t.Errorf("%d bits: %v came back as %v, more than %v out", bits, v, back, limit)
+				}
+			}
+		}
+	})
+
+	t.Run("fits in the bits", func(t *testing.T) {
+		if q := Quantise(bounds.Max, bounds, 8); q != 1<<24-1 {
+			t.Errorf("the max corner should be all ones in 24 bits not %x", q)
+		}
+		if q := Quantise(NewVector(500, -500, 5), bounds, 8); Dequantise(q, bounds, 8).X != 100 {
+			t.Error("points outside should be clamped")
+		}
+	})
+
+	t.Run("documented error", func(t *testing.T) {
+		e := QuantiseError(bounds, 10)
+		if math.Abs(e.X-200.0/1023/2) > 1e-12 {
+			t.Errorf("x error should be %f not %f", 200.0/1023/2, e.X)
+		}
+	})
+}
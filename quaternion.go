@@ -0,0 +1,89 @@
+package vector
+
+import "math"
+
+// a quaternion W + Xi + Yj + Zk, used to represent 3d rotations
+//
+// Rotations turn the same way as Rotate and RotateAxis4: clockwise looking
+// back along the axis towards the origin
+type Quaternion struct {
+	W, X, Y, Z float64
+}
+
+// the quaternion that doesn't rotate anything
+func IdentityQuaternion() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// the rotation by angle about axis (which doesn't need to be a unit vector).
+// A zero axis gives the identity, like RotateAxis
+func FromAxisAngle(axis Vector, angle float64) Quaternion {
+	if axis.MagSq() == 0 {
+		return IdentityQuaternion()
+	}
+	a := Normalise(axis)
+	s := math.Sin(-angle / 2)
+	return Quaternion{math.Cos(-angle / 2), a.X * s, a.Y * s, a.Z * s}
+}
+
+// the rotation about x by x, then about y by y, then about z by z
+func QuaternionFromEuler(x, y, z float64) Quaternion {
+	return EulerQuaternion(NewVector(x, y, z), EulerXYZ)
+}
+
+// the product q * r, the rotation r followed by q
+func (q Quaternion) Mul(r Quaternion) Quaternion {
+	return Quaternion{
+		q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+		q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+	}
+}
+
+// the conjugate of q, which is the opposite rotation for a unit quaternion
+func (q Quaternion) Conjugate() Quaternion {
+	return Quaternion{q.W, -q.X, -q.Y, -q.Z}
+}
+
+// the length of q
+func (q Quaternion) Mag() float64 {
+	return math.Sqrt(q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+}
+
+// q scaled to length 1. A zero quaternion becomes the identity
+func (q Quaternion) Normalise() Quaternion {
+	m := q.Mag()
+	if m == 0 {
+		return IdentityQuaternion()
+	}
+	return Quaternion{q.W / m, q.X / m, q.Y / m, q.Z / m}
+}
+
+// rotates v by the (unit) quaternion
+func (q Quaternion) Rotate(v Vector) Vector {
+	p := q.Mul(Quaternion{0, v.X, v.Y, v.Z}).Mul(q.Conjugate())
+	return Vector{p.X, p.Y, p.Z}
+}
+
+// the axis and angle of the rotation. The identity gives the x axis and 0
+func (q Quaternion) AxisAngle() (Vector, float64) {
+	q = q.Normalise()
+	s := math.Sqrt(1 - q.W*q.W)
+	if s < 1e-12 {
+		return NewVector(1, 0, 0), 0
+	}
+	return NewVector(q.X/s, q.Y/s, q.Z/s), -2 * math.Acos(max(-1, min(1, q.W)))
+}
+
+// the rotation as a matrix
+func (q Quaternion) Mat4() Mat4 {
+	q = q.Normalise()
+	w, x, y, z := q.W, q.X, q.Y, q.Z
+	return Mat4{
+		1 - 2*(y*y+z*z), 2 * (x*y - w*z), 2 * (x*z + w*y), 0,
+		2 * (x*y + w*z), 1 - 2*(x*x+z*z), 2 * (y*z - w*x), 0,
+		2 * (x*z - w*y), 2 * (y*z + w*x), 1 - 2*(x*x+y*y), 0,
+		0, 0, 0, 1,
+	}
+}
@@ -0,0 +1,79 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuaternion(t *testing.T) {
+	t.Run("about z matches Rotate", func(t *testing.T) {
+		v := NewVector(2, 1)
+		q := FromAxisAngle(NewVector(0, 0, 3), 0.9)
+
+		if r := q.Rotate(v); !r.Equals(Rotate(v, 0.9)) {
+			t.Errorf("should be %v not %v", Rotate(v, 0.9), r)
+		}
+	})
+
+	t.Run("zero axis", func(t *testing.T) {
+		q := FromAxisAngle(Vector{}, 1.3)
+		v := NewVector(3, 0, -1)
+
+		if q != IdentityQuaternion() {
+			t.Errorf("should be the identity not %v", q)
+		}
+		if m := RotateAxis4(Vector{}, 1.3); m != Identity4() {
+			t.Errorf("should be the identity matrix not %v", m)
+		}
+		if r := q.Rotate(v); !r.Equals(RotateAxis(v, Vector{}, 1.3)) {
+			t.Errorf("should leave %v alone like RotateAxis, got %v", v, r)
+		}
+	})
+
+	t.Run("matches the rotation matrix", func(t *testing.T) {
+		axis := NewVector(1, -2, 0.5)
+		v := NewVector(3, 0, -1)
+		q := FromAxisAngle(axis, 1.3)
+
+		expected := RotateAxis4(axis, 1.3).Transform(v)
+		if r := q.Rotate(v); !r.Equals(expected) {
+			t.Errorf("should be %v not %v", expected, r)
+		}
+		if r := q.Mat4().Transform(v); !r.Equals(expected) {
+			t.Errorf("Mat4 should be %v not %v", expected, r)
+		}
+	})
+
+	t.Run("conjugate undoes the rotation", func(t *testing.T) {
+		q := QuaternionFromEuler(0.2, -0.5, 1.4)
+		v := NewVector(1, 2, 3)
+
+		if back := q.Conjugate().Rotate(q.Rotate(v)); !back.Equals(v) {
+			t.Errorf("should come back to %v not %v", v, back)
+		}
+	})
+
+	t.Run("euler order is x then y then z", func(t *testing.T) {
+		q := QuaternionFromEuler(math.Pi/2, math.Pi/2, 0)
+		m := RotateY4(math.Pi / 2).Mul(RotateX4(math.Pi / 2))
+		v := NewVector(0, 1, 0)
+
+		if r := q.Rotate(v); !r.Equals(m.Transform(v)) {
+			t.Errorf("should be %v not %v", m.Transform(v), r)
+		}
+	})
+
+	t.Run("axis angle round trip", func(t *testing.T) {
+		axis, angle := FromAxisAngle(NewVector(0, 2, 0), 0.75).AxisAngle()
+
+		if !axis.Equals(NewVector(0, 1, 0)) && !axis.Equals(NewVector(0, -1, 0)) {
+			t.Errorf("axis should be ±y not %v", axis)
+		}
+		if r := FromAxisAngle(axis, angle).Rotate(NewVector(1, 0, 0)); !r.Equals(RotateY4(0.75).Transform(NewVector(1, 0, 0))) {
+			t.Errorf("round trip rotation wrong %v %f", axis, angle)
+		}
+		if q := (Quaternion{2, 0, 0, 0}).Normalise(); q != IdentityQuaternion() {
+			t.Errorf("should normalise to the identity not %v", q)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+// Package raster turns lines and circles into the integer cells they cover,
+// for pixels, tile grids and line of sight checks
+package raster
+
+import (
+	"math"
+	"slices"
+
+	vector "github.com/bawgafr/vector"
+)
+
+// the cells on the line from a to b (both included) by Bresenham's algorithm
+//
+// Every step moves to a neighbouring cell, including diagonally
+func LinePoints(a, b vector.IVec) []vector.IVec {
+	dx, dy := abs(b.X-a.X), -abs(b.Y-a.Y)
+	sx, sy := sign(b.X-a.X), sign(b.Y-a.Y)
+	err := dx + dy
+
+	points := make([]vector.IVec, 0, max(dx, -dy)+1)
+	p := a
+	for {
+		points = append(points, p)
+		if p == b {
+			return points
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			p.X += sx
+		}
+		if e2 <= dx {
+			err += dx
+			p.Y += sy
+		}
+	}
+}
+
+// the cells on the circle of radius r about center by the midpoint circle
+// algorithm, in order going the same way as FromAngle and starting on +x
+func CirclePoints(center vector.IVec, r int) []vector.IVec {
+	if r <= 0 {
+		return []vector.IVec{center}
+	}
+
+	// one octant, from +x round to the diagonal
+	var octant []vector.IVec
+	x, y, err := r, 0, 1-r
+	for x >= y {
+		octant = append(octant, vector.IVec{X: x, Y: y})
+		y++
+		if err < 0 {
+			err += 2*y + 1
+		} else {
+			x--
+			err += 2*(y-x) + 1
+		}
+	}
+
+	seen := make(map[vector.IVec]bool)
+	var offsets []vector.IVec
+	for _, p := range octant {
+		for _, q := range []vector.IVec{
+			{X: p.X, Y: p.Y}, {X: p.Y, Y: p.X}, {X: -p.Y, Y: p.X}, {X: -p.X, Y: p.Y},
+			{X: -p.X, Y: -p.Y}, {X: -p.Y, Y: -p.X}, {X: p.Y, Y: -p.X}, {X: p.X, Y: -p.Y},
+		} {
+			if !seen[q] {
+				seen[q] = true
+				offsets = append(offsets, q)
+			}
+		}
+	}
+
+	slices.SortFunc(offsets, func(a, b vector.IVec) int {
+		return cmpAngle(angle(a), angle(b))
+	})
+
+	points := make([]vector.IVec, len(offsets))
+	for i, o := range offsets {
+		points[i] = center.Add(o)
+	}
+	return points
+}
+
+// the heading of the offset, moved into [0, 2π) so +x comes first
+func angle(p vector.IVec) float64 {
+	h := vector.Direction(p.Vector()).Radians()
+	if h < 0 {
+		h += 2 * math.Pi
+	}
+	return h
+}
+
+func cmpAngle(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func sign(a int) int {
+	switch {
+	case a < 0:
+		return -1
+	case a > 0:
+		return 1
+	}
+	return 0
+}
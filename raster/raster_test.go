@@ -0,0 +1,77 @@
+package raster
+
+import (
+	"math"
+	"testing"
+
+	vector "github.com/bawgafr/vector"
+)
+
+func TestLinePoints(t *testing.T) {
+	t.Run("shallow line", func(t *testing.T) {
+		points := LinePoints(vector.IVec{X: 0, Y: 0}, vector.IVec{X: 5, Y: 2})
+
+		expected := []vector.IVec{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 4, Y: 2}, {X: 5, Y: 2}}
+		if len(points) != len(expected) {
+			t.Fatalf("should be %v not %v", expected, points)
+		}
+		for i := range expected {
+			if points[i] != expected[i] {
+				t.Errorf("point %d should be %v not %v", i, expected[i], points[i])
+			}
+		}
+	})
+
+	t.Run("every step is to a neighbour", func(t *testing.T) {
+		a, b := vector.IVec{X: 3, Y: 7}, vector.IVec{X: -4, Y: -9}
+		points := LinePoints(a, b)
+
+		if points[0] != a || points[len(points)-1] != b {
+			t.Errorf("should run from %v to %v, got %v to %v", a, b, points[0], points[len(points)-1])
+		}
+		if len(points) != 17 {
+			t.Errorf("should have 17 points not %d", len(points))
+		}
+		for i := 1; i < len(points); i++ {
+			d := points[i].Sub(points[i-1])
+			if abs(d.X) > 1 || abs(d.Y) > 1 {
+				t.Errorf("jump from %v to %v", points[i-1], points[i])
+			}
+		}
+	})
+
+	t.Run("single point", func(t *testing.T) {
+		p := vector.IVec{X: 2, Y: 2}
+		if points := LinePoints(p, p); len(points) != 1 || points[0] != p {
+			t.Errorf("should be just %v not %v", p, points)
+		}
+	})
+}
+
+func TestCirclePoints(t *testing.T) {
+	center := vector.IVec{X: 10, Y: -4}
+	points := CirclePoints(center, 6)
+
+	if points[0] != (vector.IVec{X: 16, Y: -4}) {
+		t.Errorf("should start on +x, got %v", points[0])
+	}
+	seen := map[vector.IVec]bool{}
+	for i, p := range points {
+		if seen[p] {
+			t.Errorf("%v is repeated", p)
+		}
+		seen[p] = true
+
+		if d := vector.Dist(p.Vector(), center.Vector()); math.Abs(d-6) > 0.5 {
+			t.Errorf("%v is %f from the centre", p, d)
+		}
+		next := points[(i+1)%len(points)].Sub(p)
+		if abs(next.X) > 1 || abs(next.Y) > 1 {
+			t.Errorf("gap between %v and %v", p, points[(i+1)%len(points)])
+		}
+	}
+	// going the FromAngle way means heading towards -y first
+	if points[1].Y >= center.Y {
+		t.Errorf("second point should be towards -y, got %v", points[1])
+	}
+}
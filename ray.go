@@ -0,0 +1,40 @@
+package vector
+
+// a half line starting at Origin going in Direction
+type Ray struct {
+	Origin, Direction Vector
+}
+
+// creates a ray, normalising the direction
+func NewRay(origin, direction Vector) Ray {
+	return Ray{origin, Normalise(direction)}
+}
+
+// the point distance t along the ray
+func (r Ray) At(t float64) Vector {
+	return Add(r.Origin, Mult(r.Direction, t))
+}
+
+// marches the ray through the signed distance field sdf (sphere tracing)
+//
+// Each step moves along the ray by the distance the field reports. Returns the
+// hit point and true once the field is within eps of a surface, or false if
+// maxSteps or maxDist run out first
+func RayMarch(ray Ray, sdf func(Vector) float64, maxSteps int, maxDist, eps float64) (Vector, bool) {
+	dir := ray.Direction
+	if m := dir.Mag(); m != 1 && m != 0 {
+		dir.Div(m)
+	}
+
+	t := 0.0
+	for i := 0; i < maxSteps && t <= maxDist; i++ {
+		p := Add(ray.Origin, Mult(dir, t))
+		d := sdf(p)
+		if d < eps {
+			return p, true
+		}
+		t += d
+	}
+
+	return Add(ray.Origin, Mult(dir, t)), false
+}
@@ -0,0 +1,33 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRayMarch(t *testing.T) {
+	sphere := func(p Vector) float64 {
+		return Dist(p, NewVector(10, 0, 0)) - 2
+	}
+
+	t.Run("hits a sphere in front of the ray", func(t *testing.T) {
+		r := NewRay(NewVector(), NewVector(1, 0, 0))
+
+		p, hit := RayMarch(r, sphere, 100, 100, 1e-6)
+		if !hit {
+			t.Fatal("ray should hit the sphere")
+		}
+		if math.Abs(p.X-8) > 1e-5 {
+			t.Errorf("hit at %v (expected {8, 0, 0})", p)
+		}
+	})
+
+	t.Run("misses a sphere behind the ray", func(t *testing.T) {
+		r := Ray{NewVector(), NewVector(-3, 0, 0)}
+
+		_, hit := RayMarch(r, sphere, 100, 50, 1e-6)
+		if hit {
+			t.Error("ray should have missed the sphere")
+		}
+	})
+}
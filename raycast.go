@@ -0,0 +1,63 @@
+package vector
+
+import "math"
+
+// walks the grid cells on the line from -> to (DDA traversal) checking each one with blocked
+//
+// Returns true and the point where the line enters the first blocked cell. If
+// nothing blocks the line it returns false and to. If the starting cell is
+// blocked then from is returned
+func RaycastGrid(from, to Vector, cellSize float64, blocked func(IVec) bool) (hit bool, at Vector) {
+	cell := CellOf(from, cellSize)
+	end := CellOf(to, cellSize)
+
+	if blocked(cell) {
+		return true, from
+	}
+
+	d := Sub(to, from)
+	stepX, tMaxX, tDeltaX := ddaAxis(from.X, d.X, cellSize, cell.X)
+	stepY, tMaxY, tDeltaY := ddaAxis(from.Y, d.Y, cellSize, cell.Y)
+
+	for cell != end {
+		// t is the fraction along the line where we cross into the next cell
+		var t float64
+		if tMaxX < tMaxY {
+			t = tMaxX
+			cell.X += stepX
+			tMaxX += tDeltaX
+		} else {
+			t = tMaxY
+			cell.Y += stepY
+			tMaxY += tDeltaY
+		}
+
+		if t > 1 {
+			break
+		}
+
+		if blocked(cell) {
+			return true, Add(from, Mult(d, t))
+		}
+	}
+
+	return false, to
+}
+
+// the step direction, the t of the first cell boundary and the t between boundaries for one axis
+func ddaAxis(start, delta, cellSize float64, cell int) (step int, tMax, tDelta float64) {
+	switch {
+	case delta > 0:
+		step = 1
+		tMax = (float64(cell+1)*cellSize - start) / delta
+		tDelta = cellSize / delta
+	case delta < 0:
+		step = -1
+		tMax = (float64(cell)*cellSize - start) / delta
+		tDelta = -cellSize / delta
+	default:
+		tMax = math.Inf(1)
+		tDelta = math.Inf(1)
+	}
+	return
+}
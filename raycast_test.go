@@ -0,0 +1,39 @@
+package vector
+
+import "testing"
+
+func TestRaycastGrid(t *testing.T) {
+	wall := func(c IVec) bool {
+		return c.X == 3
+	}
+
+	t.Run("hits a wall", func(t *testing.T) {
+		hit, at := RaycastGrid(NewVector(5, 5), NewVector(55, 5), 10, wall)
+
+		if !hit {
+			t.Fatal("should have hit the wall")
+		}
+		if !at.Equals(NewVector(30, 5)) {
+			t.Errorf("should hit the wall at {30, 5} not %v", at)
+		}
+	})
+
+	t.Run("hits a wall going diagonally backwards", func(t *testing.T) {
+		hit, at := RaycastGrid(NewVector(55, 25), NewVector(15, 5), 10, wall)
+
+		if !hit {
+			t.Fatal("should have hit the wall")
+		}
+		if !at.Equals(NewVector(40, 17.5)) {
+			t.Errorf("should hit the wall at {40, 17.5} not %v", at)
+		}
+	})
+
+	t.Run("stops before the wall", func(t *testing.T) {
+		hit, at := RaycastGrid(NewVector(5, 5), NewVector(29, 5), 10, wall)
+
+		if hit || !at.Equals(NewVector(29, 5)) {
+			t.Errorf("should not hit anything (%v %v)", hit, at)
+		}
+	})
+}
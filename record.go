@@ -0,0 +1,112 @@
+package vector
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+var ErrBadRecording = errors.New("vector: not a vector recording")
+
+// the start of every recording, the last byte being the format version
+var recordingHeader = [4]byte{'V', 'R', 'C', 1}
+
+// a Vector and when it happened, measured from the start of the recording
+type Sample struct {
+	At time.Duration
+	V  Vector
+}
+
+// the fixed size form a Sample is written in
+type sampleRecord struct {
+	At      int64
+	X, Y, Z float64
+}
+
+// writes timestamped Vectors, eg inputs or cursor positions, to a stream for a Player to replay
+//
+// Each sample takes 32 bytes, little endian. Wrap w in a bufio.Writer if it's
+// slow to write to
+type Recorder struct {
+	w       io.Writer
+	started bool
+}
+
+// creates a Recorder writing to w
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w}
+}
+
+// writes v as happening at, which shouldn't be before the last sample
+func (r *Recorder) Record(at time.Duration, v Vector) error {
+	if !r.started {
+		if _, err := r.w.Write(recordingHeader[:]); err != nil {
+			return err
+		}
+		r.started = true
+	}
+	return binary.Write(r.w, binary.LittleEndian, sampleRecord{int64(at), v.X, v.Y, v.Z})
+}
+
+// reads back what a Recorder wrote
+type Player struct {
+	r       io.Reader
+	next    Sample
+	hasNext bool
+	err     error
+}
+
+// creates a Player reading from r. An empty stream is an empty recording
+func NewPlayer(r io.Reader) (*Player, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.EOF {
+			return &Player{r: r, err: io.EOF}, nil
+		}
+		return nil, ErrBadRecording
+	}
+	if header != recordingHeader {
+		return nil, ErrBadRecording
+	}
+	return &Player{r: r}, nil
+}
+
+// the next sample, or io.EOF at the end of the recording
+func (p *Player) Next() (Sample, error) {
+	if p.hasNext {
+		p.hasNext = false
+		return p.next, nil
+	}
+	if p.err != nil {
+		return Sample{}, p.err
+	}
+
+	var rec sampleRecord
+	if err := binary.Read(p.r, binary.LittleEndian, &rec); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = ErrBadRecording
+		}
+		p.err = err
+		return Sample{}, err
+	}
+	return Sample{time.Duration(rec.At), NewVector(rec.X, rec.Y, rec.Z)}, nil
+}
+
+// every sample not yet played that happened by elapsed, for replaying with
+// the original timing. Call it each frame with the time since playback
+// started. The error is io.EOF once the whole recording has been played
+func (p *Player) Due(elapsed time.Duration) ([]Sample, error) {
+	var due []Sample
+	for {
+		s, err := p.Next()
+		if err != nil {
+			return due, err
+		}
+		if s.At > elapsed {
+			p.next, p.hasNext = s, true
+			return due, nil
+		}
+		due = append(due, s)
+	}
+}
@@ -0,0 +1,75 @@
+package vector
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestRecordReplay(t *testing.T) {
+	var buf bytes.Buffer
+	rec := NewRecorder(&buf)
+	samples := []Sample{
+		{0, NewVector(1, 2)},
+		{16 * time.Millisecond, NewVector(1.5, 2.25, -1)},
+		{40 * time.Millisecond, NewVector(-3, 0)},
+		{41 * time.Millisecond, NewVector(0, 1e-7)},
+	}
+	for _, s := range samples {
+		if err := rec.Record(s.At, s.V); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if buf.Len() != 4+32*len(samples) {
+		t.Errorf("should be %d bytes not %d", 4+32*len(samples), buf.Len())
+	}
+
+	t.Run("next reads everything back", func(t *testing.T) {
+		p, err := NewPlayer(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, expected := range samples {
+			s, err := p.Next()
+			if err != nil || s != expected {
+				t.Errorf("sample %d should be %v not %v (%v)", i, expected, s, err)
+			}
+		}
+		if _, err := p.Next(); err != io.EOF {
+			t.Errorf("should be io.EOF at the end not %v", err)
+		}
+	})
+
+	t.Run("due keeps the timing", func(t *testing.T) {
+		p, _ := NewPlayer(bytes.NewReader(buf.Bytes()))
+
+		if due, err := p.Due(10 * time.Millisecond); len(due) != 1 || err != nil {
+			t.Errorf("should have 1 sample due at 10ms not %v (%v)", due, err)
+		}
+		if due, _ := p.Due(20 * time.Millisecond); len(due) != 1 || due[0] != samples[1] {
+			t.Errorf("should have the 16ms sample due at 20ms not %v", due)
+		}
+		if due, err := p.Due(time.Second); len(due) != 2 || err != io.EOF {
+			t.Errorf("should have the last 2 samples and io.EOF not %v (%v)", due, err)
+		}
+	})
+
+	t.Run("bad streams", func(t *testing.T) {
+		if _, err := NewPlayer(bytes.NewReader([]byte("nope"))); err != ErrBadRecording {
+			t.Errorf("should be ErrBadRecording not %v", err)
+		}
+		p, _ := NewPlayer(bytes.NewReader(buf.Bytes()[:40]))
+		p.Next()
+		if _, err := p.Next(); err != ErrBadRecording {
+			t.Errorf("a cut off sample should be ErrBadRecording not %v", err)
+		}
+		empty, err := NewPlayer(bytes.NewReader(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := empty.Next(); err != io.EOF {
+			t.Errorf("an empty stream should be io.EOF not %v", err)
+		}
+	})
+}
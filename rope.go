@@ -0,0 +1,71 @@
+package vector
+
+// a rope hanging under gravity, simulated as a line of Verlet points joined by sticks
+//
+// Gravity is in screen coordinates (y down) by default; scale it to suit.
+// Iterations is how many times the sticks are satisfied each update and
+// Damping (0 to 1) how much velocity is kept from one update to the next
+type Rope struct {
+	Points     []VerletPoint
+	Sticks     []Stick
+	Gravity    Vector
+	Iterations int
+	Damping    float64
+}
+
+// a rope from start to end made of segments equal sticks, with nothing pinned
+func NewRope(start, end Vector, segments int) *Rope {
+	segments = max(segments, 1)
+	r := &Rope{
+		Points:     make([]VerletPoint, segments+1),
+		Sticks:     make([]Stick, segments),
+		Gravity:    NewVector(0, 9.81),
+		Iterations: 10,
+		Damping:    0.99,
+	}
+	for i := range r.Points {
+		r.Points[i] = NewVerletPoint(Lerp(start, end, float64(i)/float64(segments)))
+	}
+	for i := range r.Sticks {
+		r.Sticks[i] = NewStick(r.Points, i, i+1)
+	}
+	return r
+}
+
+// advances the rope by dt
+func (r *Rope) Update(dt float64) {
+	for i := range r.Points {
+		r.Points[i].Step(r.Gravity, dt, r.Damping)
+	}
+	SatisfySticks(r.Points, r.Sticks, r.Iterations)
+}
+
+// pins the first point at p. Call it each frame to drag the rope around
+func (r *Rope) PinStart(p Vector) {
+	r.pin(0, p)
+}
+
+// pins the last point at p
+func (r *Rope) PinEnd(p Vector) {
+	r.pin(len(r.Points)-1, p)
+}
+
+func (r *Rope) pin(i int, p Vector) {
+	r.Points[i].Place(p)
+	r.Points[i].Pinned = true
+}
+
+// lets both ends go
+func (r *Rope) Unpin() {
+	r.Points[0].Pinned = false
+	r.Points[len(r.Points)-1].Pinned = false
+}
+
+// the current positions, for drawing
+func (r *Rope) Positions() []Vector {
+	ps := make([]Vector, len(r.Points))
+	for i, p := range r.Points {
+		ps[i] = p.Pos
+	}
+	return ps
+}
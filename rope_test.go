@@ -0,0 +1,48 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRope(t *testing.T) {
+	t.Run("hangs below the pin", func(t *testing.T) {
+		r := NewRope(NewVector(0, 0), NewVector(10, 0), 10)
+		r.PinStart(NewVector(0, 0))
+
+		for range 2000 {
+			r.Update(1.0 / 60)
+		}
+
+		ps := r.Positions()
+		if !ps[0].Equals(NewVector(0, 0)) {
+			t.Errorf("pinned start moved to %v", ps[0])
+		}
+		end := ps[len(ps)-1]
+		if math.Abs(end.X) > 0.5 || end.Y < 9.5 {
+			t.Errorf("end should hang near (0, 10) not %v", end)
+		}
+	})
+
+	t.Run("keeps its length", func(t *testing.T) {
+		r := NewRope(NewVector(0, 0), NewVector(10, 0), 5)
+		r.PinStart(NewVector(0, 0))
+		r.PinEnd(NewVector(6, 0))
+
+		for range 500 {
+			r.Update(1.0 / 60)
+		}
+
+		length := 0.0
+		ps := r.Positions()
+		for i := 1; i < len(ps); i++ {
+			length += Dist(ps[i-1], ps[i])
+		}
+		if math.Abs(length-10) > 0.1 {
+			t.Errorf("length should stay about 10 not %f", length)
+		}
+		if ps[2].Y <= 0 {
+			t.Errorf("slack rope should sag not %v", ps[2])
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package vector
+
+// how the world is fitted to the viewport when their aspect ratios differ
+type AspectMode int
+
+const (
+	// scale each axis independently so the world fills the viewport exactly
+	Stretch AspectMode = iota
+	// keep the aspect ratio and fit the whole world inside the viewport (letterboxing)
+	Fit
+	// keep the aspect ratio and fill the whole viewport, cropping the world
+	Fill
+)
+
+// maps points between world space and a screen/viewport of Width x Height pixels
+//
+// Screen space has the origin in the top left with y going down. Set FlipY if
+// the world has y going up.
+type ScreenMapper struct {
+	World         AABB
+	Width, Height float64
+	Aspect        AspectMode
+	FlipY         bool
+}
+
+// creates a ScreenMapper for the world bounds and viewport size
+func NewScreenMapper(world AABB, width, height float64, aspect AspectMode) ScreenMapper {
+	return ScreenMapper{World: world, Width: width, Height: height, Aspect: aspect}
+}
+
+// works out the scale and offset used to take world coords to screen coords
+func (m ScreenMapper) transform() (sx, sy, ox, oy float64) {
+	size := m.World.Size()
+	sx = m.Width / size.X
+	sy = m.Height / size.Y
+
+	switch m.Aspect {
+	case Fit:
+		s := min(sx, sy)
+		sx, sy = s, s
+	case Fill:
+		s := max(sx, sy)
+		sx, sy = s, s
+	}
+
+	// centre the world in the viewport
+	ox = (m.Width - size.X*sx) / 2
+	oy = (m.Height - size.Y*sy) / 2
+	return
+}
+
+// converts a point in world space to screen space
+func (m ScreenMapper) ToScreen(p Vector) Vector {
+	sx, sy, ox, oy := m.transform()
+
+	x := (p.X-m.World.Min.X)*sx + ox
+	y := (p.Y-m.World.Min.Y)*sy + oy
+	if m.FlipY {
+		y = (m.World.Max.Y-p.Y)*sy + oy
+	}
+
+	return NewVector(x, y)
+}
+
+// converts a point in screen space back to world space
+func (m ScreenMapper) ToWorld(p Vector) Vector {
+	sx, sy, ox, oy := m.transform()
+
+	x := (p.X-ox)/sx + m.World.Min.X
+	y := (p.Y-oy)/sy + m.World.Min.Y
+	if m.FlipY {
+		y = m.World.Max.Y - (p.Y-oy)/sy
+	}
+
+	return NewVector(x, y)
+}
+
+// the scaled world bounds in screen space. With Fit this is the area inside the letterbox
+func (m ScreenMapper) Viewport() AABB {
+	sx, sy, ox, oy := m.transform()
+	size := m.World.Size()
+	return AABB{NewVector(ox, oy), NewVector(ox+size.X*sx, oy+size.Y*sy)}
+}
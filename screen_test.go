@@ -0,0 +1,53 @@
+package vector
+
+import "testing"
+
+func TestScreenMapper(t *testing.T) {
+	world := NewAABB(NewVector(-10, -5), NewVector(10, 5))
+
+	t.Run("stretch maps the corners to the viewport corners", func(t *testing.T) {
+		m := NewScreenMapper(world, 400, 400, Stretch)
+
+		tl := m.ToScreen(NewVector(-10, -5))
+		br := m.ToScreen(NewVector(10, 5))
+
+		if !tl.Equals(NewVector(0, 0)) || !br.Equals(NewVector(400, 400)) {
+			t.Errorf("corners mapped wrong %v %v", tl, br)
+		}
+	})
+
+	t.Run("fit letterboxes the world", func(t *testing.T) {
+		m := NewScreenMapper(world, 400, 400, Fit)
+
+		vp := m.Viewport()
+		expected := AABB{NewVector(0, 100), NewVector(400, 300)}
+
+		if !vp.Min.Equals(expected.Min) || !vp.Max.Equals(expected.Max) {
+			t.Errorf("viewport wrong %v (expected %v)", vp, expected)
+		}
+	})
+
+	t.Run("flip y", func(t *testing.T) {
+		m := NewScreenMapper(world, 200, 100, Fit)
+		m.FlipY = true
+
+		p := m.ToScreen(NewVector(-10, 5))
+		if !p.Equals(NewVector(0, 0)) {
+			t.Errorf("top left of a y-up world should be {0,0} not %v", p)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, aspect := range []AspectMode{Stretch, Fit, Fill} {
+			m := NewScreenMapper(world, 640, 480, aspect)
+			m.FlipY = aspect == Fill
+
+			p := NewVector(3.5, -1.25)
+			back := m.ToWorld(m.ToScreen(p))
+
+			if !back.Equals(p) {
+				t.Errorf("aspect %d: %v came back as %v", aspect, p, back)
+			}
+		}
+	})
+}
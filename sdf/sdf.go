@@ -0,0 +1,90 @@
+// Package sdf has signed distance functions for 2d shapes in the xy plane
+//
+// Each shape is a func(vector.Vector) float64 returning the distance from the point
+// to the edge of the shape: negative inside, positive outside. The Z component
+// of the point is ignored.
+package sdf
+
+import (
+	"math"
+
+	vector "github.com/bawgafr/vector"
+)
+
+// a circle of radius r around center
+func Circle(center vector.Vector, r float64) func(vector.Vector) float64 {
+	return func(p vector.Vector) float64 {
+		return math.Hypot(p.X-center.X, p.Y-center.Y) - r
+	}
+}
+
+// an axis aligned box around center. halfExtents holds half the width and height
+func Box(center, halfExtents vector.Vector) func(vector.Vector) float64 {
+	return func(p vector.Vector) float64 {
+		qx := math.Abs(p.X-center.X) - halfExtents.X
+		qy := math.Abs(p.Y-center.Y) - halfExtents.Y
+
+		outside := math.Hypot(math.Max(qx, 0), math.Max(qy, 0))
+		inside := math.Min(math.Max(qx, qy), 0)
+		return outside + inside
+	}
+}
+
+// the line segment from a to b. The segment has no inside so use Round to give it thickness
+func Segment(a, b vector.Vector) func(vector.Vector) float64 {
+	return func(p vector.Vector) float64 {
+		pax, pay := p.X-a.X, p.Y-a.Y
+		bax, bay := b.X-a.X, b.Y-a.Y
+
+		h := 0.0
+		if l := bax*bax + bay*bay; l > 0 {
+			h = math.Max(0, math.Min(1, (pax*bax+pay*bay)/l))
+		}
+
+		return math.Hypot(pax-bax*h, pay-bay*h)
+	}
+}
+
+// grows the shape outwards by r, rounding its corners
+func Round(f func(vector.Vector) float64, r float64) func(vector.Vector) float64 {
+	return func(p vector.Vector) float64 {
+		return f(p) - r
+	}
+}
+
+// the area covered by either shape
+func Union(a, b func(vector.Vector) float64) func(vector.Vector) float64 {
+	return func(p vector.Vector) float64 {
+		return math.Min(a(p), b(p))
+	}
+}
+
+// the area covered by both shapes
+func Intersect(a, b func(vector.Vector) float64) func(vector.Vector) float64 {
+	return func(p vector.Vector) float64 {
+		return math.Max(a(p), b(p))
+	}
+}
+
+// the area of a with b cut out of it
+func Subtract(a, b func(vector.Vector) float64) func(vector.Vector) float64 {
+	return func(p vector.Vector) float64 {
+		return math.Max(a(p), -b(p))
+	}
+}
+
+// the step used for the central differences in Normal
+const normalEps = 1e-5
+
+// the unit normal of the field at p, calculated with central differences
+func Normal(f func(vector.Vector) float64, p vector.Vector) vector.Vector {
+	dx := f(vector.NewVector(p.X+normalEps, p.Y, p.Z)) - f(vector.NewVector(p.X-normalEps, p.Y, p.Z))
+	dy := f(vector.NewVector(p.X, p.Y+normalEps, p.Z)) - f(vector.NewVector(p.X, p.Y-normalEps, p.Z))
+
+	n := vector.NewVector(dx, dy)
+	if n.MagSq() == 0 {
+		return n
+	}
+	n.Normalise()
+	return n
+}
@@ -0,0 +1,72 @@
+package sdf
+
+import (
+	"math"
+	"testing"
+
+	vector "github.com/bawgafr/vector"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestShapes(t *testing.T) {
+	t.Run("circle", func(t *testing.T) {
+		c := Circle(vector.NewVector(1, 1), 2)
+
+		if d := c(vector.NewVector(1, 1)); !near(d, -2) {
+			t.Errorf("centre should be -2 not %f", d)
+		}
+		if d := c(vector.NewVector(1, 5)); !near(d, 2) {
+			t.Errorf("should be 2 outside not %f", d)
+		}
+	})
+
+	t.Run("box", func(t *testing.T) {
+		b := Box(vector.NewVector(), vector.NewVector(2, 1))
+
+		if d := b(vector.NewVector(0, 0.5)); !near(d, -0.5) {
+			t.Errorf("inside should be -0.5 not %f", d)
+		}
+		if d := b(vector.NewVector(5, 5)); !near(d, 5) {
+			t.Errorf("corner distance should be 5 not %f", d)
+		}
+	})
+
+	t.Run("segment", func(t *testing.T) {
+		s := Segment(vector.NewVector(0, 0), vector.NewVector(10, 0))
+
+		if d := s(vector.NewVector(5, 3)); !near(d, 3) {
+			t.Errorf("should be 3 from the middle not %f", d)
+		}
+		if d := s(vector.NewVector(-4, 3)); !near(d, 5) {
+			t.Errorf("should be 5 from the end not %f", d)
+		}
+	})
+}
+
+func TestCombinators(t *testing.T) {
+	a := Circle(vector.NewVector(0, 0), 1)
+	b := Circle(vector.NewVector(1.5, 0), 1)
+	p := vector.NewVector(-0.5, 0)
+
+	if d := Union(a, b)(p); !near(d, -0.5) {
+		t.Errorf("union should be -0.5 not %f", d)
+	}
+	if d := Intersect(a, b)(p); !near(d, 1) {
+		t.Errorf("intersect should be 1 not %f", d)
+	}
+	if d := Subtract(a, b)(vector.NewVector(0.75, 0)); !near(d, 0.25) {
+		t.Errorf("subtract should be 0.25 not %f", d)
+	}
+}
+
+func TestNormal(t *testing.T) {
+	c := Circle(vector.NewVector(), 1)
+
+	n := Normal(c, vector.NewVector(0, 3))
+	if vector.Dist(n, vector.NewVector(0, 1)) > 1e-6 {
+		t.Errorf("normal should be {0, 1} not %v", n)
+	}
+}
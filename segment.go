@@ -0,0 +1,22 @@
+package vector
+
+// a straight line between A and B
+type Segment struct {
+	A, B Vector
+}
+
+// the length of the segment
+func (s Segment) Length() float64 {
+	return Dist(s.A, s.B)
+}
+
+// the closest point on the segment to p
+func (s Segment) ClosestPoint(p Vector) Vector {
+	c, _ := closestOnSegment(s.A, s.B, p)
+	return c
+}
+
+// the shortest distance from p to the segment
+func (s Segment) Dist(p Vector) float64 {
+	return Dist(p, s.ClosestPoint(p))
+}
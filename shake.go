@@ -0,0 +1,44 @@
+package vector
+
+// a screen shake: a decaying, noise driven offset to add to a camera position
+//
+// Trigger adds to the strength (0 to 1), which falls by Decay per second. The
+// offset is Amplitude times the square of the strength, so small knocks stay
+// gentle, and wobbles about Frequency times a second
+type Shake struct {
+	Amplitude, Frequency, Decay float64
+
+	strength float64
+	last     float64
+	started  bool
+}
+
+// creates a Shake
+func NewShake(amplitude, frequency, decay float64) *Shake {
+	return &Shake{Amplitude: amplitude, Frequency: frequency, Decay: decay}
+}
+
+// adds strength to the shake, up to a maximum of 1
+func (s *Shake) Trigger(strength float64) {
+	s.strength = max(0, min(1, s.strength+strength))
+}
+
+// the current strength, 0 to 1
+func (s *Shake) Strength() float64 {
+	return s.strength
+}
+
+// the offset at time t in seconds. t should only go forwards between calls
+func (s *Shake) Offset(t float64) Vector {
+	if s.started {
+		s.strength = max(0, s.strength-s.Decay*max(0, t-s.last))
+	}
+	s.last = t
+	s.started = true
+
+	if s.strength == 0 {
+		return Vector{}
+	}
+	n := Turbulence(NewVector(t*s.Frequency, 0), 0)
+	return Mult(n, s.Amplitude*s.strength*s.strength)
+}
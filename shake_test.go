@@ -0,0 +1,44 @@
+package vector
+
+import "testing"
+
+func TestShake(t *testing.T) {
+	t.Run("still until triggered", func(t *testing.T) {
+		s := NewShake(10, 20, 1)
+
+		if o := s.Offset(0.5); !o.Equals(Vector{}) {
+			t.Errorf("should be zero not %v", o)
+		}
+	})
+
+	t.Run("decays to nothing", func(t *testing.T) {
+		s := NewShake(10, 20, 2)
+		s.Offset(0)
+		s.Trigger(0.8)
+
+		biggest := 0.0
+		for i := 1; i <= 30; i++ {
+			o := s.Offset(float64(i) / 60)
+			biggest = max(biggest, o.Mag())
+			if o.Mag() > 10*0.64*1.5 {
+				t.Errorf("offset %v is bigger than the strength allows", o)
+			}
+		}
+		if biggest == 0 {
+			t.Error("should have shaken")
+		}
+		if s.Offset(1); s.Strength() != 0 {
+			t.Errorf("should have decayed to 0 not %f", s.Strength())
+		}
+	})
+
+	t.Run("strength is capped", func(t *testing.T) {
+		s := NewShake(1, 1, 1)
+		s.Trigger(0.7)
+		s.Trigger(0.7)
+
+		if s.Strength() != 1 {
+			t.Errorf("should be 1 not %f", s.Strength())
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package vector
+
+import "math"
+
+// the corners of a regular polygon with radius r (centre to corner)
+//
+// The first corner is at angle rotation and the rest follow in the same
+// direction as FromAngle
+func RegularPolygon(center Vector, r float64, sides int, rotation float64) []Vector {
+	if sides <= 0 {
+		return []Vector{}
+	}
+
+	points := make([]Vector, sides)
+	step := 2 * math.Pi / float64(sides)
+	for i := range points {
+		points[i] = Add(center, FromAngle(rotation+float64(i)*step, r))
+	}
+	return points
+}
+
+// the 2*points corners of a star, alternating between rOuter and rInner
+//
+// The first outer point is on the positive x axis
+func Star(center Vector, rOuter, rInner float64, points int) []Vector {
+	if points <= 0 {
+		return []Vector{}
+	}
+
+	corners := make([]Vector, 2*points)
+	step := math.Pi / float64(points)
+	for i := range corners {
+		r := rOuter
+		if i%2 == 1 {
+			r = rInner
+		}
+		corners[i] = Add(center, FromAngle(float64(i)*step, r))
+	}
+	return corners
+}
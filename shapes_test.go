@@ -0,0 +1,39 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRegularPolygon(t *testing.T) {
+	sq := RegularPolygon(NewVector(), math.Sqrt2, 4, math.Pi/4)
+
+	expected := []Vector{NewVector(1, -1), NewVector(-1, -1), NewVector(-1, 1), NewVector(1, 1)}
+	for i := range expected {
+		if !sq[i].Equals(expected[i]) {
+			t.Errorf("corner %d should be %v not %v", i, expected[i], sq[i])
+		}
+	}
+
+	if a := Polygon(RegularPolygon(NewVector(3, 3), 1, 6, 0)).Area(); !compare(t, a, 3*math.Sqrt(3)/2) {
+		t.Errorf("hexagon area should be %f not %f", 3*math.Sqrt(3)/2, a)
+	}
+}
+
+func TestStar(t *testing.T) {
+	c := NewVector(2, 2)
+	s := Star(c, 5, 2, 5)
+
+	if len(s) != 10 {
+		t.Fatalf("should have 10 corners not %d", len(s))
+	}
+	for i, p := range s {
+		r := 5.0
+		if i%2 == 1 {
+			r = 2
+		}
+		if !compare(t, Dist(p, c), r) {
+			t.Errorf("corner %d should be %f out not %f", i, r, Dist(p, c))
+		}
+	}
+}
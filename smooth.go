@@ -0,0 +1,62 @@
+package vector
+
+import "math"
+
+// smooths a path with Chaikin's corner cutting
+//
+// Each iteration replaces every corner with two points a quarter and three
+// quarters of the way along its edges. The first and last points are kept so
+// the path still starts and ends in the same place
+func SmoothChaikin(path []Vector, iterations int) []Vector {
+	return SmoothChaikinAdaptive(path, iterations, -1)
+}
+
+// like SmoothChaikin but only cuts corners that turn by more than maxTurn radians
+//
+// Nearly straight sections are left alone so the path doesn't gain points it
+// doesn't need. Stops early once every corner turns by maxTurn or less
+func SmoothChaikinAdaptive(path []Vector, iterations int, maxTurn float64) []Vector {
+	out := append([]Vector{}, path...)
+	if len(out) < 3 {
+		return out
+	}
+
+	for i := 0; i < iterations; i++ {
+		next := []Vector{out[0]}
+		cut := false
+
+		for j := 1; j < len(out)-1; j++ {
+			a, b, c := out[j-1], out[j], out[j+1]
+
+			if turnAngle(a, b, c) <= maxTurn {
+				next = append(next, b)
+				continue
+			}
+
+			next = append(next, Lerp(a, b, 0.75), Lerp(b, c, 0.25))
+			cut = true
+		}
+
+		next = append(next, out[len(out)-1])
+		out = next
+
+		if !cut {
+			break
+		}
+	}
+
+	return out
+}
+
+// the change in heading going a -> b -> c
+func turnAngle(a, b, c Vector) float64 {
+	in := Sub(b, a)
+	out := Sub(c, b)
+	if in.MagSq() == 0 || out.MagSq() == 0 {
+		return 0
+	}
+
+	// clamp against rounding taking the cosine just outside [-1, 1]
+	cos := in.DotProduct(out) / (in.Mag() * out.Mag())
+	return math.Acos(max(-1, min(1, cos)))
+}
@@ -0,0 +1,45 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSmoothChaikin(t *testing.T) {
+	path := []Vector{NewVector(0, 0), NewVector(4, 0), NewVector(4, 4)}
+
+	t.Run("one iteration cuts the corner", func(t *testing.T) {
+		s := SmoothChaikin(path, 1)
+		expected := []Vector{NewVector(0, 0), NewVector(3, 0), NewVector(4, 1), NewVector(4, 4)}
+
+		if len(s) != len(expected) {
+			t.Fatalf("expected %d points not %d %v", len(expected), len(s), s)
+		}
+		for i := range s {
+			if !s[i].Equals(expected[i]) {
+				t.Errorf("point %d is %v (expected %v)", i, s[i], expected[i])
+			}
+		}
+	})
+
+	t.Run("keeps the end points", func(t *testing.T) {
+		s := SmoothChaikin(path, 4)
+
+		if !s[0].Equals(path[0]) || !s[len(s)-1].Equals(path[2]) {
+			t.Errorf("end points moved %v %v", s[0], s[len(s)-1])
+		}
+	})
+
+	t.Run("adaptive stops once corners are gentle", func(t *testing.T) {
+		s := SmoothChaikinAdaptive(path, 100, math.Pi/8)
+
+		for i := 1; i < len(s)-1; i++ {
+			if a := turnAngle(s[i-1], s[i], s[i+1]); a > math.Pi/8 {
+				t.Errorf("corner %d still turns by %f", i, a)
+			}
+		}
+		if len(s) > 20 {
+			t.Errorf("should have stopped early, got %d points", len(s))
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package vector
+
+import "math"
+
+// the centre of the square grid cell of size cell that v is in, which is the
+// nearest cell centre. Z is left alone
+func Snap(v Vector, cell float64) Vector {
+	return SnapVec(v, NewVector(cell, cell))
+}
+
+// the same as Snap for cells cell.X wide and cell.Y high
+func SnapVec(v, cell Vector) Vector {
+	return Vector{snap(v.X, cell.X), snap(v.Y, cell.Y), v.Z}
+}
+
+func snap(a, cell float64) float64 {
+	if cell == 0 {
+		return a
+	}
+	return (math.Floor(a/cell) + 0.5) * cell
+}
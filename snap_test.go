@@ -0,0 +1,19 @@
+package vector
+
+import "testing"
+
+func TestSnap(t *testing.T) {
+	for _, tc := range []struct{ v, expected Vector }{
+		{NewVector(3, 7), NewVector(5, 5)},
+		{NewVector(10, 19.9), NewVector(15, 15)},
+		{NewVector(-1, -12, 4), NewVector(-5, -15, 4)},
+	} {
+		if s := Snap(tc.v, 10); !s.Equals(tc.expected) {
+			t.Errorf("%v should snap to %v not %v", tc.v, tc.expected, s)
+		}
+	}
+
+	if s := SnapVec(NewVector(7, 7), NewVector(4, 16)); !s.Equals(NewVector(6, 8)) {
+		t.Errorf("should snap to (6, 8) not %v", s)
+	}
+}
@@ -0,0 +1,82 @@
+package vector
+
+import "math"
+
+// integer cell coordinates used to bucket points that are close to each other
+type cellKey struct {
+	x, y, z int64
+}
+
+func keyFor(p Vector, size float64) cellKey {
+	return cellKey{
+		int64(math.Floor(p.X / size)),
+		int64(math.Floor(p.Y / size)),
+		int64(math.Floor(p.Z / size)),
+	}
+}
+
+// calls f with every cell in the 3x3x3 block around k
+func neighbourCells(k cellKey, f func(cellKey)) {
+	for dx := int64(-1); dx <= 1; dx++ {
+		for dy := int64(-1); dy <= 1; dy++ {
+			for dz := int64(-1); dz <= 1; dz++ {
+				f(cellKey{k.x + dx, k.y + dy, k.z + dz})
+			}
+		}
+	}
+}
+
+// buckets points into cubic cells so the ones near a position can be found
+// without checking them all
+//
+// Points are referred to by the index Insert gives them, which is their
+// position in the order they were added
+type SpatialHash struct {
+	CellSize float64
+
+	buckets map[cellKey][]int
+	points  []Vector
+}
+
+// creates an empty SpatialHash. cellSize works best at about the query radius
+func NewSpatialHash(cellSize float64) *SpatialHash {
+	return &SpatialHash{CellSize: cellSize, buckets: map[cellKey][]int{}}
+}
+
+// adds p and returns its index
+func (h *SpatialHash) Insert(p Vector) int {
+	i := len(h.points)
+	h.points = append(h.points, p)
+	k := keyFor(p, h.CellSize)
+	h.buckets[k] = append(h.buckets[k], i)
+	return i
+}
+
+// the point with index i
+func (h *SpatialHash) Point(i int) Vector {
+	return h.points[i]
+}
+
+// the number of points added
+func (h *SpatialHash) Len() int {
+	return len(h.points)
+}
+
+// the indices of every point within r of p (inclusive), in no particular order
+func (h *SpatialHash) Query(p Vector, r float64) []int {
+	var out []int
+	rSq := r * r
+	lo, hi := keyFor(Sub(p, NewVector(r, r, r)), h.CellSize), keyFor(Add(p, NewVector(r, r, r)), h.CellSize)
+	for x := lo.x; x <= hi.x; x++ {
+		for y := lo.y; y <= hi.y; y++ {
+			for z := lo.z; z <= hi.z; z++ {
+				for _, i := range h.buckets[cellKey{x, y, z}] {
+					if MagSq(Sub(h.points[i], p)) <= rSq {
+						out = append(out, i)
+					}
+				}
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package vector
+
+// the steering force that turns vel towards target at maxSpeed
+func Seek(pos, vel, target Vector, maxSpeed float64) Vector {
+	desired := Sub(target, pos)
+	if desired.MagSq() == 0 {
+		return Mult(vel, -1)
+	}
+
+	desired.SetMag(maxSpeed)
+	return Sub(desired, vel)
+}
+
+// like Seek but slows down inside slowRadius so it comes to a stop on target
+func Arrive(pos, vel, target Vector, maxSpeed, slowRadius float64) Vector {
+	desired := Sub(target, pos)
+	d := desired.Mag()
+	if d == 0 {
+		return Mult(vel, -1)
+	}
+
+	speed := maxSpeed
+	if d < slowRadius {
+		speed = maxSpeed * d / slowRadius
+	}
+
+	desired.SetMag(speed)
+	return Sub(desired, vel)
+}
+
+// steers an agent along a Path (Reynolds' path following)
+//
+// The agent looks LookAhead along the path from where it is and seeks that
+// point, arriving at the end of the path
+type PathFollower struct {
+	Path      *Path
+	LookAhead float64
+	MaxSpeed  float64
+	MaxForce  float64
+	// distance from the end of the path to start slowing down
+	ArriveRadius float64
+}
+
+// creates a PathFollower. ArriveRadius defaults to the look ahead distance
+func NewPathFollower(path *Path, lookAhead, maxSpeed, maxForce float64) *PathFollower {
+	return &PathFollower{
+		Path:         path,
+		LookAhead:    lookAhead,
+		MaxSpeed:     maxSpeed,
+		MaxForce:     maxForce,
+		ArriveRadius: lookAhead,
+	}
+}
+
+// the steering force for an agent at pos moving at vel
+func (f *PathFollower) Steer(pos, vel Vector) Vector {
+	_, along := f.Path.Closest(pos)
+	length := f.Path.Length()
+
+	var force Vector
+	if along+f.LookAhead >= length {
+		force = Arrive(pos, vel, f.Path.PointAt(length), f.MaxSpeed, f.ArriveRadius)
+	} else {
+		force = Seek(pos, vel, f.Path.PointAt(along+f.LookAhead), f.MaxSpeed)
+	}
+
+	if f.MaxForce > 0 {
+		force.Limit(f.MaxForce)
+	}
+	return force
+}
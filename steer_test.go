@@ -0,0 +1,53 @@
+package vector
+
+import "testing"
+
+func TestPath(t *testing.T) {
+	p := NewPath(1, NewVector(0, 0), NewVector(10, 0), NewVector(10, 10))
+
+	if l := p.Length(); l != 20 {
+		t.Errorf("length should be 20 not %f", l)
+	}
+
+	if pt := p.PointAt(15); !pt.Equals(NewVector(10, 5)) {
+		t.Errorf("15 along should be {10, 5} not %v", pt)
+	}
+
+	pt, along := p.Closest(NewVector(4, 3))
+	if !pt.Equals(NewVector(4, 0)) || along != 4 {
+		t.Errorf("closest should be {4, 0} 4 along not %v %f", pt, along)
+	}
+}
+
+func TestPathFollower(t *testing.T) {
+	path := NewPath(1, NewVector(0, 0), NewVector(100, 0))
+
+	t.Run("follows the path", func(t *testing.T) {
+		f := NewPathFollower(path, 10, 5, 0)
+		pos := NewVector(20, 0)
+
+		s := f.Steer(pos, NewVector())
+		if !s.Equals(NewVector(5, 0)) {
+			t.Errorf("should seek along the path at max speed not %v", s)
+		}
+	})
+
+	t.Run("arrives at the end", func(t *testing.T) {
+		f := NewPathFollower(path, 10, 5, 0)
+		pos := NewVector(95, 0)
+
+		s := f.Steer(pos, NewVector(5, 0))
+		if !s.Equals(NewVector(-2.5, 0)) {
+			t.Errorf("should slow down to half speed not %v", s)
+		}
+	})
+
+	t.Run("limits the force", func(t *testing.T) {
+		f := NewPathFollower(path, 10, 5, 1)
+
+		s := f.Steer(NewVector(20, 30), NewVector(-5, 0))
+		if s.Mag() > 1+1e-9 {
+			t.Errorf("force should be limited to 1 not %f", s.Mag())
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package vector
+
+import "math"
+
+// a fixed timestep accumulator, for running a simulation at a steady rate
+// whatever the frame rate and drawing it smoothly in between
+//
+// Step is the simulation timestep and MaxSteps caps how many steps one frame
+// can ask for, so a long stall doesn't leave the simulation forever catching
+// up. Scale speeds time up or slows it down: NewStepper sets it to 1 for
+// normal speed, 0 pauses and negative values count as 0
+type Stepper struct {
+	Step     float64
+	MaxSteps int
+	Scale    float64
+
+	accumulated float64
+}
+
+// creates a Stepper that allows up to 5 steps a frame
+func NewStepper(step float64) *Stepper {
+	return &Stepper{Step: step, MaxSteps: 5, Scale: 1}
+}
+
+// adds a frame's worth of time and returns how many fixed steps to run and
+// how far (0 to 1) the frame is between the last step and the next, for Interpolate
+func (s *Stepper) Advance(frameDt float64) (steps int, alpha float64) {
+	if s.Step <= 0 {
+		return 0, 0
+	}
+
+	s.accumulated += max(0, frameDt) * max(0, s.Scale)
+
+	// work the steps out in one go so a long stall doesn't loop over every one
+	n := math.Floor(s.accumulated / s.Step)
+	s.accumulated -= n * s.Step
+	// the division can round either way
+	if s.accumulated < 0 {
+		n--
+		s.accumulated += s.Step
+	} else if s.accumulated >= s.Step {
+		n++
+		s.accumulated -= s.Step
+	}
+
+	if s.MaxSteps > 0 && n > float64(s.MaxSteps) {
+		// drop the time that couldn't be caught up
+		s.accumulated = 0
+		return s.MaxSteps, 0
+	}
+	return int(n), s.accumulated / s.Step
+}
+
+// forgets any time left over
+func (s *Stepper) Reset() {
+	s.accumulated = 0
+}
+
+// the position to draw something at alpha of the way from its previous step to its current one
+func Interpolate(prev, curr Vector, alpha float64) Vector {
+	return Lerp(prev, curr, alpha)
+}
@@ -0,0 +1,81 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStepper(t *testing.T) {
+	t.Run("accumulates frames", func(t *testing.T) {
+		s := NewStepper(0.01)
+
+		total := 0
+		for range 10 {
+			steps, alpha := s.Advance(0.025)
+			total += steps
+			if alpha < 0 || alpha >= 1 {
+				t.Errorf("alpha should be in [0, 1) not %f", alpha)
+			}
+		}
+		if total != 25 {
+			t.Errorf("0.25s should be 25 steps not %d", total)
+		}
+	})
+
+	t.Run("caps steps after a stall", func(t *testing.T) {
+		s := NewStepper(0.01)
+		steps, alpha := s.Advance(1)
+
+		if steps != 5 || alpha != 0 {
+			t.Errorf("should be 5 steps and alpha 0 not %d and %f", steps, alpha)
+		}
+		if steps, _ := s.Advance(0.005); steps != 0 {
+			t.Errorf("shouldn't carry the stall over, got %d steps", steps)
+		}
+	})
+
+	t.Run("huge stall", func(t *testing.T) {
+		s := NewStepper(1e-9)
+		steps, alpha := s.Advance(1e9)
+
+		if steps != 5 || alpha != 0 {
+			t.Errorf("should be 5 steps and alpha 0 not %d and %f", steps, alpha)
+		}
+	})
+
+	t.Run("zero scale pauses", func(t *testing.T) {
+		s := NewStepper(0.1)
+		s.Advance(0.25)
+		s.Scale = 0
+		steps, alpha := s.Advance(0.25)
+
+		if steps != 0 || math.Abs(alpha-0.5) > 1e-9 {
+			t.Errorf("should be 0 steps with alpha still 0.5 not %d and %f", steps, alpha)
+		}
+	})
+
+	t.Run("negative scale pauses", func(t *testing.T) {
+		s := NewStepper(0.1)
+		s.Advance(0.25)
+		s.Scale = -1
+		steps, alpha := s.Advance(0.25)
+
+		if steps != 0 || math.Abs(alpha-0.5) > 1e-9 {
+			t.Errorf("should be 0 steps with alpha still 0.5 not %d and %f", steps, alpha)
+		}
+	})
+
+	t.Run("time scale", func(t *testing.T) {
+		s := NewStepper(0.1)
+		s.Scale = 0.5
+		steps, alpha := s.Advance(0.25)
+
+		if steps != 1 || math.Abs(alpha-0.25) > 1e-9 {
+			t.Errorf("should be 1 step with alpha 0.25 not %d and %f", steps, alpha)
+		}
+	})
+
+	if p := Interpolate(NewVector(0, 0), NewVector(10, 4), 0.25); !p.Equals(NewVector(2.5, 1)) {
+		t.Errorf("should be (2.5, 1) not %v", p)
+	}
+}
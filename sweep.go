@@ -0,0 +1,107 @@
+package vector
+
+import "math"
+
+// sweeps circle c along vel and finds when it first touches seg
+//
+// t is the fraction of vel travelled before the hit (0 to 1) and normal points
+// from the segment towards the circle's centre at that time. A circle that
+// already overlaps the segment hits at t = 0
+func SweepCircleSegment(c Circle, vel Vector, seg Segment) (t float64, normal Vector, hit bool) {
+	t = math.Inf(1)
+
+	// already touching
+	if seg.Dist(c.Center) <= c.R {
+		return 0, contactNormal(seg.ClosestPoint(c.Center), c.Center, vel), true
+	}
+
+	// the flat sides of the segment
+	ab := Sub(seg.B, seg.A)
+	if l := ab.Mag(); l > 0 {
+		n := NewVector(-ab.Y/l, ab.X/l)
+		s0 := DotProduct(Sub(c.Center, seg.A), n)
+		vn := DotProduct(vel, n)
+
+		if vn != 0 {
+			side := math.Copysign(1, s0)
+			ts := (side*c.R - s0) / vn
+			if ts >= 0 && ts <= 1 {
+				p := Add(c.Center, Mult(vel, ts))
+				along := DotProduct(Sub(p, seg.A), ab) / (l * l)
+				if along >= 0 && along <= 1 {
+					t = ts
+					normal = Mult(n, side)
+				}
+			}
+		}
+	}
+
+	// the rounded ends
+	for _, end := range []Vector{seg.A, seg.B} {
+		if te, ok := sweepPointCircle(c.Center, vel, end, c.R); ok && te < t {
+			t = te
+			normal = Normalise(Sub(Add(c.Center, Mult(vel, te)), end))
+		}
+	}
+
+	if math.IsInf(t, 1) {
+		return 0, Vector{}, false
+	}
+	return t, normal, true
+}
+
+// sweeps a along velA and b along velB and finds when they first touch
+//
+// t is the fraction of the step travelled (0 to 1). normal points from b's
+// centre to a's centre at the time of the hit
+func SweepCircleCircle(a Circle, velA Vector, b Circle, velB Vector) (t float64, normal Vector, hit bool) {
+	rel := Sub(velA, velB)
+
+	t, hit = sweepPointCircle(a.Center, rel, b.Center, a.R+b.R)
+	if !hit {
+		return 0, Vector{}, false
+	}
+
+	pa := Add(a.Center, Mult(velA, t))
+	pb := Add(b.Center, Mult(velB, t))
+	return t, contactNormal(pb, pa, rel), true
+}
+
+// earliest t in [0, 1] where p + vel*t is r from center
+func sweepPointCircle(p, vel, center Vector, r float64) (float64, bool) {
+	d := Sub(p, center)
+	c := d.MagSq() - r*r
+	if c <= 0 {
+		return 0, true
+	}
+
+	a := vel.MagSq()
+	b := 2 * d.DotProduct(vel)
+	if a == 0 || b >= 0 {
+		// not moving, or moving away
+		return 0, false
+	}
+
+	disc := b*b - 4*a*c
+	if disc < 0 {
+		return 0, false
+	}
+
+	t := (-b - math.Sqrt(disc)) / (2 * a)
+	if t > 1 {
+		return 0, false
+	}
+	return t, true
+}
+
+// the unit vector from -> to, falling back to against the velocity if they are on top of each other
+func contactNormal(from, to, vel Vector) Vector {
+	n := Sub(to, from)
+	if n.MagSq() == 0 {
+		n = Mult(vel, -1)
+	}
+	if n.MagSq() == 0 {
+		return Vector{}
+	}
+	return Normalise(n)
+}
@@ -0,0 +1,56 @@
+package vector
+
+import "testing"
+
+func TestSweepCircleSegment(t *testing.T) {
+	wall := Segment{NewVector(10, -5), NewVector(10, 5)}
+
+	t.Run("hits the flat side", func(t *testing.T) {
+		c := Circle{NewVector(0, 0), 1}
+
+		at, n, hit := SweepCircleSegment(c, NewVector(18, 0), wall)
+		if !hit {
+			t.Fatal("should hit the wall")
+		}
+		if !compare(t, at, 0.5) || !n.Equals(NewVector(-1, 0)) {
+			t.Errorf("should hit half way with normal {-1, 0} not %f %v", at, n)
+		}
+	})
+
+	t.Run("hits the end cap", func(t *testing.T) {
+		c := Circle{NewVector(0, 5.6), 1}
+
+		at, n, hit := SweepCircleSegment(c, NewVector(20, 0), wall)
+		if !hit {
+			t.Fatal("should clip the end of the wall")
+		}
+		if !compare(t, at, 0.46) || !n.Equals(NewVector(-0.8, 0.6)) {
+			t.Errorf("should hit at 0.46 with normal {-0.8, 0.6} not %f %v", at, n)
+		}
+	})
+
+	t.Run("misses", func(t *testing.T) {
+		c := Circle{NewVector(0, 0), 1}
+
+		if _, _, hit := SweepCircleSegment(c, NewVector(5, 0), wall); hit {
+			t.Error("should not reach the wall")
+		}
+	})
+}
+
+func TestSweepCircleCircle(t *testing.T) {
+	a := Circle{NewVector(0, 0), 1}
+	b := Circle{NewVector(10, 0), 1}
+
+	at, n, hit := SweepCircleCircle(a, NewVector(4, 0), b, NewVector(-4, 0))
+	if !hit {
+		t.Fatal("circles should collide")
+	}
+	if !compare(t, at, 1) || !n.Equals(NewVector(-1, 0)) {
+		t.Errorf("should touch at the end of the step with normal {-1, 0} not %f %v", at, n)
+	}
+
+	if _, _, hit := SweepCircleCircle(a, NewVector(0, 4), b, NewVector()); hit {
+		t.Error("should not collide")
+	}
+}
@@ -0,0 +1,43 @@
+package vector
+
+import (
+	"math"
+	"time"
+)
+
+// works out the direction and speed of a swipe from its position samples and their times
+//
+// dir is the unit vector from the first sample to the last, speed is the
+// distance covered per second and cardinal is "right", "up", "left" or "down"
+// on a y-down screen. Fewer than two samples, mismatched slices or no movement
+// give a zero dir, 0 and ""
+func ClassifySwipe(samples []Vector, times []time.Time) (dir Vector, speed float64, cardinal string) {
+	if len(samples) < 2 || len(samples) != len(times) {
+		return Vector{}, 0, ""
+	}
+
+	d := Sub(samples[len(samples)-1], samples[0])
+	d.Z = 0
+	dist := d.Mag()
+	if dist == 0 {
+		return Vector{}, 0, ""
+	}
+
+	if secs := times[len(times)-1].Sub(times[0]).Seconds(); secs > 0 {
+		speed = dist / secs
+	}
+
+	h := heading(d)
+	switch {
+	case math.Abs(h) <= math.Pi/4:
+		cardinal = "right"
+	case h > math.Pi/4 && h <= 3*math.Pi/4:
+		cardinal = "up"
+	case h < -math.Pi/4 && h >= -3*math.Pi/4:
+		cardinal = "down"
+	default:
+		cardinal = "left"
+	}
+
+	return Div(d, dist), speed, cardinal
+}
@@ -0,0 +1,42 @@
+package vector
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClassifySwipe(t *testing.T) {
+	start := time.Unix(0, 0)
+	times := []time.Time{start, start.Add(100 * time.Millisecond), start.Add(200 * time.Millisecond)}
+
+	tests := []struct {
+		name     string
+		samples  []Vector
+		cardinal string
+		dir      Vector
+	}{
+		{"right", []Vector{NewVector(0, 0), NewVector(10, 1), NewVector(30, 0)}, "right", NewVector(1, 0)},
+		{"up", []Vector{NewVector(0, 0), NewVector(0, -10), NewVector(0, -30)}, "up", NewVector(0, -1)},
+		{"left", []Vector{NewVector(0, 0), NewVector(-10, 5), NewVector(-30, 0)}, "left", NewVector(-1, 0)},
+		{"down", []Vector{NewVector(5, 0), NewVector(5, 10), NewVector(5, 30)}, "down", NewVector(0, 1)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, speed, cardinal := ClassifySwipe(tc.samples, times)
+
+			if cardinal != tc.cardinal || !dir.Equals(tc.dir) {
+				t.Errorf("should be %s %v not %s %v", tc.cardinal, tc.dir, cardinal, dir)
+			}
+			if !compare(t, speed, 150) {
+				t.Errorf("speed should be 150 not %f", speed)
+			}
+		})
+	}
+
+	t.Run("not enough samples", func(t *testing.T) {
+		if _, _, c := ClassifySwipe([]Vector{NewVector()}, times[:1]); c != "" {
+			t.Errorf("should not classify a single sample as %s", c)
+		}
+	})
+}
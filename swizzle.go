@@ -0,0 +1,59 @@
+package vector
+
+// GLSL style swizzles. Each returns a new vector made from the named
+// components in order, with any missing ones left as 0
+
+// the vector (x, y, 0)
+func (v Vector) XY() Vector {
+	return Vector{X: v.X, Y: v.Y}
+}
+
+// the vector (x, z, 0)
+func (v Vector) XZ() Vector {
+	return Vector{X: v.X, Y: v.Z}
+}
+
+// the vector (y, x, 0)
+func (v Vector) YX() Vector {
+	return Vector{X: v.Y, Y: v.X}
+}
+
+// the vector (y, z, 0)
+func (v Vector) YZ() Vector {
+	return Vector{X: v.Y, Y: v.Z}
+}
+
+// the vector (z, x, 0)
+func (v Vector) ZX() Vector {
+	return Vector{X: v.Z, Y: v.X}
+}
+
+// the vector (z, y, 0)
+func (v Vector) ZY() Vector {
+	return Vector{X: v.Z, Y: v.Y}
+}
+
+// the vector (x, z, y)
+func (v Vector) XZY() Vector {
+	return Vector{v.X, v.Z, v.Y}
+}
+
+// the vector (y, x, z)
+func (v Vector) YXZ() Vector {
+	return Vector{v.Y, v.X, v.Z}
+}
+
+// the vector (y, z, x)
+func (v Vector) YZX() Vector {
+	return Vector{v.Y, v.Z, v.X}
+}
+
+// the vector (z, x, y)
+func (v Vector) ZXY() Vector {
+	return Vector{v.Z, v.X, v.Y}
+}
+
+// the vector (z, y, x)
+func (v Vector) ZYX() Vector {
+	return Vector{v.Z, v.Y, v.X}
+}
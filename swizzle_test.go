@@ -0,0 +1,21 @@
+package vector
+
+import "testing"
+
+func TestSwizzle(t *testing.T) {
+	v := NewVector(1, 2, 3)
+
+	for name, tc := range map[string]struct{ got, expected Vector }{
+		"XY":  {v.XY(), NewVector(1, 2)},
+		"YX":  {v.YX(), NewVector(2, 1)},
+		"XZ":  {v.XZ(), NewVector(1, 3)},
+		"ZY":  {v.ZY(), NewVector(3, 2)},
+		"XZY": {v.XZY(), NewVector(1, 3, 2)},
+		"ZYX": {v.ZYX(), NewVector(3, 2, 1)},
+		"YZX": {v.YZX(), NewVector(2, 3, 1)},
+	} {
+		if !tc.got.Equals(tc.expected) {
+			t.Errorf("%s should be %v not %v", name, tc.expected, tc.got)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package vector
+
+// a stack of 2d transforms that works like p5's push(), pop(), translate(),
+// rotate() and scale(), for building nested coordinate frames
+//
+// The zero value is ready to use and starts at the identity
+type TransformStack struct {
+	current Affine2D
+	saved   []Affine2D
+	started bool
+}
+
+// creates a TransformStack starting at the identity
+func NewTransformStack() *TransformStack {
+	return &TransformStack{current: IdentityAffine2D(), started: true}
+}
+
+func (s *TransformStack) init() {
+	if !s.started {
+		s.current = IdentityAffine2D()
+		s.started = true
+	}
+}
+
+// saves the current transform
+func (s *TransformStack) Push() *TransformStack {
+	s.init()
+	s.saved = append(s.saved, s.current)
+	return s
+}
+
+// goes back to the last saved transform. Popping an empty stack resets to the identity
+func (s *TransformStack) Pop() *TransformStack {
+	if len(s.saved) == 0 {
+		s.current = IdentityAffine2D()
+		s.started = true
+		return s
+	}
+	s.current = s.saved[len(s.saved)-1]
+	s.saved = s.saved[:len(s.saved)-1]
+	return s
+}
+
+// moves the origin by tx, ty in the current frame
+func (s *TransformStack) Translate(tx, ty float64) *TransformStack {
+	s.init()
+	s.current = s.current.Translate(tx, ty)
+	return s
+}
+
+// rotates the current frame by angle, the same way as Rotate
+func (s *TransformStack) Rotate(angle float64) *TransformStack {
+	s.init()
+	s.current = s.current.Rotate(angle)
+	return s
+}
+
+// scales the current frame
+func (s *TransformStack) Scale(sx, sy float64) *TransformStack {
+	s.init()
+	s.current = s.current.Scale(sx, sy)
+	return s
+}
+
+// the current transform from local to world space
+func (s *TransformStack) Current() Affine2D {
+	s.init()
+	return s.current
+}
+
+// the world position of the local point p
+func (s *TransformStack) ToWorld(p Vector) Vector {
+	return s.Current().Apply(p)
+}
+
+// the local position of the world point p, or p itself if the frame is degenerate
+func (s *TransformStack) ToLocal(p Vector) Vector {
+	inv, ok := s.Current().Inverse()
+	if !ok {
+		return p
+	}
+	return inv.Apply(p)
+}
+
+// the number of saved transforms
+func (s *TransformStack) Depth() int {
+	return len(s.saved)
+}
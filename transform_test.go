@@ -0,0 +1,46 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTransformStack(t *testing.T) {
+	t.Run("arm with joints", func(t *testing.T) {
+		var s TransformStack
+		s.Translate(10, 0).Rotate(-math.Pi / 2)
+		s.Push()
+		s.Translate(5, 0).Rotate(-math.Pi / 2)
+		hand := s.ToWorld(NewVector(2, 0))
+		s.Pop()
+		elbow := s.ToWorld(NewVector(5, 0))
+
+		// each -π/2 turns +x to +y
+		if !elbow.Equals(NewVector(10, 5)) {
+			t.Errorf("elbow should be (10, 5) not %v", elbow)
+		}
+		if !hand.Equals(NewVector(8, 5)) {
+			t.Errorf("hand should be (8, 5) not %v", hand)
+		}
+		if s.Depth() != 0 {
+			t.Errorf("depth should be 0 not %d", s.Depth())
+		}
+	})
+
+	t.Run("to local undoes to world", func(t *testing.T) {
+		s := NewTransformStack().Translate(3, -1).Scale(2, 4).Rotate(0.4)
+		p := NewVector(1, 2)
+
+		if back := s.ToLocal(s.ToWorld(p)); !back.Equals(p) {
+			t.Errorf("should come back to %v not %v", p, back)
+		}
+	})
+
+	t.Run("pop on empty resets", func(t *testing.T) {
+		s := NewTransformStack().Translate(3, 3).Pop()
+
+		if s.Current() != IdentityAffine2D() {
+			t.Errorf("should be the identity not %v", s.Current())
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package vector
+
+import "math"
+
+// a triangle in the xy plane
+type Triangle struct {
+	A, B, C Vector
+}
+
+// the area of the triangle
+func (t Triangle) Area() float64 {
+	return math.Abs(cross2(t.A, t.B, t.C)) / 2
+}
+
+// the average of the corners
+func (t Triangle) Centroid() Vector {
+	return Div(Add(Add(t.A, t.B), t.C), 3)
+}
+
+// check if the point is inside the triangle or on its edge
+func (t Triangle) Contains(p Vector) bool {
+	d1 := cross2(t.A, t.B, p)
+	d2 := cross2(t.B, t.C, p)
+	d3 := cross2(t.C, t.A, p)
+	neg := d1 < 0 || d2 < 0 || d3 < 0
+	pos := d1 > 0 || d2 > 0 || d3 > 0
+	return !(neg && pos)
+}
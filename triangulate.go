@@ -0,0 +1,85 @@
+package vector
+
+import "errors"
+
+var (
+	ErrTooFewPoints = errors.New("vector: a polygon needs at least 3 points")
+	ErrNotSimple    = errors.New("vector: polygon crosses itself or has no area")
+)
+
+// splits a simple polygon (no holes and no crossing edges) into triangles by ear clipping
+//
+// The triangles wind the same way as the polygon. Points on a straight edge
+// don't get triangles of their own
+func Triangulate(poly []Vector) ([]Triangle, error) {
+	n := len(poly)
+	if n < 3 {
+		return nil, ErrTooFewPoints
+	}
+
+	winding := 1.0
+	if Polygon(poly).signedArea() < 0 {
+		winding = -1
+	}
+
+	remaining := make([]int, n)
+	for i := range remaining {
+		remaining[i] = i
+	}
+
+	triangles := make([]Triangle, 0, n-2)
+	for len(remaining) > 3 {
+		ear := -1
+		for i := range remaining {
+			if isEar(poly, remaining, i, winding) {
+				ear = i
+				break
+			}
+		}
+		if ear < 0 {
+			return nil, ErrNotSimple
+		}
+
+		m := len(remaining)
+		a, b, c := poly[remaining[(ear+m-1)%m]], poly[remaining[ear]], poly[remaining[(ear+1)%m]]
+		if cross2(a, b, c) != 0 {
+			triangles = append(triangles, Triangle{a, b, c})
+		}
+		remaining = append(remaining[:ear], remaining[ear+1:]...)
+	}
+
+	last := Triangle{poly[remaining[0]], poly[remaining[1]], poly[remaining[2]]}
+	if cross2(last.A, last.B, last.C) != 0 {
+		triangles = append(triangles, last)
+	}
+	if len(triangles) == 0 {
+		return nil, ErrNotSimple
+	}
+	return triangles, nil
+}
+
+// check if the i'th remaining point is an ear: a convex (or straight) corner
+// with no other remaining point inside the triangle it makes with its neighbours
+func isEar(poly []Vector, remaining []int, i int, winding float64) bool {
+	m := len(remaining)
+	ia, ib, ic := remaining[(i+m-1)%m], remaining[i], remaining[(i+1)%m]
+	t := Triangle{poly[ia], poly[ib], poly[ic]}
+	if cross2(t.A, t.B, t.C)*winding < 0 {
+		return false
+	}
+
+	for _, j := range remaining {
+		if j == ia || j == ib || j == ic {
+			continue
+		}
+		p := poly[j]
+		// points that coincide with a corner are fine, anywhere else inside isn't
+		if p.Equals(t.A) || p.Equals(t.B) || p.Equals(t.C) {
+			continue
+		}
+		if t.Contains(p) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTriangulate(t *testing.T) {
+	t.Run("concave polygon", func(t *testing.T) {
+		// an arrow head with a notch cut into it
+		poly := []Vector{NewVector(0, 0), NewVector(4, 2), NewVector(8, 0), NewVector(4, 8)}
+		tris, err := Triangulate(poly)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(tris) != 2 {
+			t.Errorf("should be 2 triangles not %d", len(tris))
+		}
+		area := 0.0
+		for _, tri := range tris {
+			area += tri.Area()
+			if !Polygon(poly).Contains(tri.Centroid()) {
+				t.Errorf("triangle %v is outside the polygon", tri)
+			}
+		}
+		if math.Abs(area-Polygon(poly).Area()) > 1e-9 {
+			t.Errorf("areas should add up to %f not %f", Polygon(poly).Area(), area)
+		}
+	})
+
+	t.Run("keeps the winding", func(t *testing.T) {
+		poly := []Vector{NewVector(0, 0), NewVector(0, 5), NewVector(5, 5), NewVector(5, 0), NewVector(2.5, 2)}
+		tris, err := Triangulate(poly)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sign := Polygon(poly).signedArea()
+		for _, tri := range tris {
+			if cross2(tri.A, tri.B, tri.C)*sign <= 0 {
+				t.Errorf("triangle %v winds the wrong way", tri)
+			}
+		}
+	})
+
+	t.Run("straight edges", func(t *testing.T) {
+		poly := []Vector{NewVector(0, 0), NewVector(1, 0), NewVector(2, 0), NewVector(2, 2), NewVector(0, 2)}
+		tris, err := Triangulate(poly)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		area := 0.0
+		for _, tri := range tris {
+			if tri.Area() == 0 {
+				t.Errorf("%v has no area", tri)
+			}
+			area += tri.Area()
+		}
+		if math.Abs(area-4) > 1e-9 {
+			t.Errorf("area should be 4 not %f", area)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := Triangulate([]Vector{NewVector(0, 0), NewVector(1, 1)}); err != ErrTooFewPoints {
+			t.Errorf("should be ErrTooFewPoints not %v", err)
+		}
+		if _, err := Triangulate([]Vector{NewVector(0, 0), NewVector(1, 1), NewVector(2, 2)}); err != ErrNotSimple {
+			t.Errorf("a line should be ErrNotSimple not %v", err)
+		}
+	})
+}
+
+func TestTriangleContains(t *testing.T) {
+	tri := Triangle{NewVector(0, 0), NewVector(4, 0), NewVector(0, 4)}
+
+	if !tri.Contains(NewVector(1, 1)) || !tri.Contains(NewVector(2, 0)) {
+		t.Error("should contain points inside and on the edge")
+	}
+	if tri.Contains(NewVector(3, 3)) {
+		t.Error("shouldn't contain (3, 3)")
+	}
+}
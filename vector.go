@@ -2,11 +2,12 @@ package vector
 
 import (
 	"fmt"
-	"log"
 	"math"
 	"math/rand"
 )
 
+// the methods with pointer receivers change the vector in place and return
+// it so calls can be chained, eg v.Add(a).Mult(0.5).Limit(maxSpeed)
 type Vector struct {
 	X, Y, Z float64
 }
@@ -17,28 +18,38 @@ type Vector struct {
 // *set(x,y,z) set() and all in between
 // *copy()
 // *add(Vector)
-// rem -- modulo
+// *rem(Vector) -- modulo
 // *sub(Vector)
 // *mult(float64)
 // *dev(float64)
+// *mult/div(Vector) -- component-wise, as MultVec and DivVec
 // *mag() -- calculates the magnitude of the vector
 // *magSq() -- calculates the square of the magnitude
 // *dot(Vector) -- dot product of 2 2d vectors
+// *cross(Vector) -- cross product of 2 3d vectors
+// *tripleProductScalar/Vector(a, b, c) -- a·(b×c) and a×(b×c) (not in p5)
 // *dist(Vector) -- the distance between 2 vectors
 // *normalise -- scales the vector so the mag = 1
 // *limit(float64) sets a max value for magnitude if the value is > than it
 // **angleBetween(Vector) returns the angle between this and the passe vector
+// *signedAngle2D(Vector) the signed 2d angle to turn to the passed vector (not in p5)
 // *equals(Vector) -- x==X && y==Y && z==Z
+// *isZero(), isUnit(), isParallel(Vector), isPerpendicular(Vector) -- with an optional tolerance (not in p5)
 // *setMag(float64) sets the magnitude of the vector
-// *heading() calcs the angle a 2d vector makes with the positive x axis. Angles increase clockwise
+// *heading() calcs the signed angle a 2d vector makes with the positive x axis. Angles increase clockwise (towards +y) as in p5
 // *rotate(float64) rotates a vector without changing magnitude
+// *rotateAxis(Vector, float64) rotates a 3d vector about an axis (not in p5)
+// *rotateX/Y/Z(float64) rotates a 3d vector about a coordinate axis (not in p5)
 // *random2d() -- creates a new 2d unit vector with a random heading
 // *random3d() -- creates a new 3d unit vector with a random heading
 // *fromAngle(float64) -- creates a 2d vector from the passed angle
-
-// setHeading() rotates a 2d vector to a specific angle without changing magnitude
-
-// lerp, slerp
+// *reflect(Vector) -- reflects the vector off a surface with the passed normal
+// *lerp(Vector, float64) -- linear interpolation between 2 vectors
+// *slerp(Vector, float64) -- spherical interpolation between 2 vectors
+// *setHeading(float64) rotates a 2d vector to a specific angle without changing magnitude
+// *min/max(Vector), clamp(Vector, Vector) -- component-wise (not in p5)
+// *abs(), negate(), floor(), ceil(), round() -- component-wise (not in p5)
+// *wrap(Vector, Vector) -- wraps each component into a range (not in p5)
 
 func (v Vector) String() string {
 	return fmt.Sprintf("{%2f, %2f, %2f}", v.X, v.Y, v.Z)
@@ -63,6 +74,63 @@ func (v1 Vector) Equals(v2 Vector) bool {
 	return x < 1e-9 && y < 1e-9 && z < 1e-9
 }
 
+// the tolerance the Is predicates use when none is passed, the same one Equals uses
+const DefaultTolerance = 1e-9
+
+func tolerance(tol []float64) float64 {
+	if len(tol) > 0 {
+		return tol[0]
+	}
+	return DefaultTolerance
+}
+
+// check if v is within tolerance (DefaultTolerance if omitted) of the zero vector
+func IsZero(v Vector, tol ...float64) bool {
+	return v.Mag() <= tolerance(tol)
+}
+
+// check if this vector is within tolerance of the zero vector
+func (v Vector) IsZero(tol ...float64) bool {
+	return IsZero(v, tol...)
+}
+
+// check if the magnitude of v is within tolerance (DefaultTolerance if omitted) of 1
+func IsUnit(v Vector, tol ...float64) bool {
+	return math.Abs(v.Mag()-1) <= tolerance(tol)
+}
+
+// check if this vector's magnitude is within tolerance of 1
+func (v Vector) IsUnit(tol ...float64) bool {
+	return IsUnit(v, tol...)
+}
+
+// check if v1 and v2 point the same or opposite ways, in 2d or 3d
+//
+// tolerance (DefaultTolerance if omitted) is on the sine of the angle between
+// them so it doesn't depend on their lengths. The zero vector is parallel to everything
+func IsParallel(v1, v2 Vector, tol ...float64) bool {
+	return Cross(v1, v2).Mag() <= tolerance(tol)*v1.Mag()*v2.Mag()
+}
+
+// check if this vector and other point the same or opposite ways
+func (v Vector) IsParallel(other Vector, tol ...float64) bool {
+	return IsParallel(v, other, tol...)
+}
+
+// check if v1 and v2 are at right angles, in 2d or 3d
+//
+// tolerance (DefaultTolerance if omitted) is on the cosine of the angle
+// between them so it doesn't depend on their lengths. The zero vector is
+// perpendicular to everything
+func IsPerpendicular(v1, v2 Vector, tol ...float64) bool {
+	return math.Abs(DotProduct(v1, v2)) <= tolerance(tol)*v1.Mag()*v2.Mag()
+}
+
+// check if this vector and other are at right angles
+func (v Vector) IsPerpendicular(other Vector, tol ...float64) bool {
+	return IsPerpendicular(v, other, tol...)
+}
+
 func NewVector(values ...float64) Vector {
 	x := 0.0
 	y := 0.0
@@ -101,7 +169,7 @@ func Random3d() Vector {
 // Set(a) will set {a, 0, 0}.
 // Set(a,b) will set {a, b, 0}.
 // Set(a, b, c) will set {a, b, c}
-func (v *Vector) Set(values ...float64) {
+func (v *Vector) Set(values ...float64) *Vector {
 	l := len(values)
 
 	if l == 0 {
@@ -120,6 +188,7 @@ func (v *Vector) Set(values ...float64) {
 		v.Z = values[2]
 	}
 
+	return v
 }
 
 // returns a new copy of the vector
@@ -133,10 +202,12 @@ func Add(v1, v2 Vector) Vector {
 }
 
 // adds the vector to this one
-func (v *Vector) Add(other Vector) {
+func (v *Vector) Add(other Vector) *Vector {
 	v.X += other.X
 	v.Y += other.Y
 	v.Z += other.Z
+
+	return v
 }
 
 // subtract the two vectors and return a new Vector
@@ -145,10 +216,35 @@ func Sub(v1, v2 Vector) Vector {
 }
 
 // subtract the vector from this one
-func (v *Vector) Sub(other Vector) {
+func (v *Vector) Sub(other Vector) *Vector {
 	v.X -= other.X
 	v.Y -= other.Y
 	v.Z -= other.Z
+
+	return v
+}
+
+// component-wise remainder of v1 / v2 (math.Mod) and returns a new Vector
+//
+// components of v2 that are zero leave that component of v1 unchanged
+func Rem(v1, v2 Vector) Vector {
+	return Vector{rem(v1.X, v2.X), rem(v1.Y, v2.Y), rem(v1.Z, v2.Z)}
+}
+
+// replaces each component of this vector with its remainder after dividing by other
+func (v *Vector) Rem(other Vector) *Vector {
+	v.X = rem(v.X, other.X)
+	v.Y = rem(v.Y, other.Y)
+	v.Z = rem(v.Z, other.Z)
+
+	return v
+}
+
+func rem(a, b float64) float64 {
+	if b == 0 {
+		return a
+	}
+	return math.Mod(a, b)
 }
 
 // multiply the vector by m and return a new Vector
@@ -157,10 +253,12 @@ func Mult(v Vector, m float64) Vector {
 }
 
 // multiply this vector by m
-func (v *Vector) Mult(m float64) {
+func (v *Vector) Mult(m float64) *Vector {
 	v.X *= m
 	v.Y *= m
 	v.Z *= m
+
+	return v
 }
 
 // scalar divide the vector by d
@@ -169,10 +267,42 @@ func Div(v Vector, d float64) Vector {
 }
 
 // scalar divide this by amount d
-func (v *Vector) Div(d float64) {
+func (v *Vector) Div(d float64) *Vector {
 	v.X /= d
 	v.Y /= d
 	v.Z /= d
+
+	return v
+}
+
+// multiply each component of v by the matching one of m, eg to scale x and y by different amounts
+func MultVec(v, m Vector) Vector {
+	return Vector{v.X * m.X, v.Y * m.Y, v.Z * m.Z}
+}
+
+// multiply each component of this vector by the matching one of m
+func (v *Vector) MultVec(m Vector) *Vector {
+	*v = MultVec(*v, m)
+	return v
+}
+
+// divide each component of v by the matching one of d. Components divided
+// by 0 are left alone, so 2d vectors can be divided by 2d vectors
+func DivVec(v, d Vector) Vector {
+	return Vector{div(v.X, d.X), div(v.Y, d.Y), div(v.Z, d.Z)}
+}
+
+// divide each component of this vector by the matching one of d
+func (v *Vector) DivVec(d Vector) *Vector {
+	*v = DivVec(*v, d)
+	return v
+}
+
+func div(a, b float64) float64 {
+	if b == 0 {
+		return a
+	}
+	return a / b
 }
 
 // returns the magnitude of the passed in Vector
@@ -195,7 +325,7 @@ func (v Vector) Mag() float64 {
 	return math.Sqrt(v.MagSq())
 }
 
-// angle between 2 vectors
+// angle between 2 vectors, in [0, π]. Separation gives it as an Angle
 func AngleBetween(v1, v2 Vector) float64 {
 	// acos( (v1.v2)/(|v1| |v2|)
 	v1m := v1.Mag()
@@ -216,6 +346,27 @@ func (v Vector) AngleBetween(other Vector) float64 {
 	return math.Acos(dp / (v1m * v2m))
 }
 
+// the signed angle to turn v1 by to point along v2 in 2d, in (-π, π]
+//
+// It goes the same way as Rotate, so Rotate(v1, SignedAngle2D(v1, v2)) points
+// along v2. Positive turns towards -y, which on a y-down screen is
+// anticlockwise (a left turn), and negative is clockwise (a right turn).
+// Z is ignored
+func SignedAngle2D(v1, v2 Vector) float64 {
+	cross := v1.X*v2.Y - v1.Y*v2.X
+	dot := v1.X*v2.X + v1.Y*v2.Y
+	a := math.Atan2(-cross, dot)
+	if a == -math.Pi {
+		return math.Pi
+	}
+	return a
+}
+
+// the signed angle to turn this vector by to point along other in 2d, see SignedAngle2D
+func (v Vector) SignedAngle2D(other Vector) float64 {
+	return SignedAngle2D(v, other)
+}
+
 // returns the dot product of the Vectors
 func DotProduct(v1, v2 Vector) float64 {
 	return v1.X*v2.X + v1.Y*v2.Y + v1.Z*v2.Z
@@ -226,6 +377,35 @@ func (v Vector) DotProduct(other Vector) float64 {
 	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
 }
 
+// returns the cross product of the Vectors
+func Cross(v1, v2 Vector) Vector {
+	return Vector{
+		v1.Y*v2.Z - v1.Z*v2.Y,
+		v1.Z*v2.X - v1.X*v2.Z,
+		v1.X*v2.Y - v1.Y*v2.X,
+	}
+}
+
+// returns the cross product of this vector with the passed in one
+func (v Vector) Cross(other Vector) Vector {
+	return Vector{
+		v.Y*other.Z - v.Z*other.Y,
+		v.Z*other.X - v.X*other.Z,
+		v.X*other.Y - v.Y*other.X,
+	}
+}
+
+// a · (b × c), the signed volume of the parallelepiped with edges a, b and c.
+// It is 0 when the three are coplanar and positive when they are right handed
+func TripleProductScalar(a, b, c Vector) float64 {
+	return DotProduct(a, Cross(b, c))
+}
+
+// a × (b × c), which lies in the plane of b and c and equals b(a·c) - c(a·b)
+func TripleProductVector(a, b, c Vector) Vector {
+	return Cross(a, Cross(b, c))
+}
+
 // Distance between the two vectors
 func Dist(v1, v2 Vector) float64 {
 	dx := v1.X - v2.X
@@ -248,6 +428,63 @@ func (v Vector) Dist(other Vector) float64 {
 	return math.Sqrt(sq)
 }
 
+// reflects v off a surface with the passed normal. The normal doesn't need to be a unit vector
+func Reflect(v, normal Vector) Vector {
+	n := Normalise(normal)
+	return Sub(v, Mult(n, 2*v.DotProduct(n)))
+}
+
+// reflects this vector off a surface with the passed normal
+func (v *Vector) Reflect(normal Vector) *Vector {
+	n := Normalise(normal)
+	v.Sub(Mult(n, 2*v.DotProduct(n)))
+
+	return v
+}
+
+// the part of v that points along other (vector projection)
+func ProjectOnto(v, other Vector) Vector {
+	m := other.MagSq()
+	if m == 0 {
+		return Vector{}
+	}
+	return Mult(other, v.DotProduct(other)/m)
+}
+
+// replaces this vector with the part of it that points along other
+func (v *Vector) ProjectOnto(other Vector) *Vector {
+	*v = ProjectOnto(*v, other)
+
+	return v
+}
+
+// the signed length of v along the direction of onto (scalar projection),
+// negative if v points away from it. 0 if onto is the zero vector
+func ScalarProject(v, onto Vector) float64 {
+	m := onto.Mag()
+	if m == 0 {
+		return 0
+	}
+	return v.DotProduct(onto) / m
+}
+
+// the signed length of this vector along the direction of onto, see ScalarProject
+func (v Vector) ScalarProject(onto Vector) float64 {
+	return ScalarProject(v, onto)
+}
+
+// the part of v that is perpendicular to other (vector rejection)
+func RejectFrom(v, other Vector) Vector {
+	return Sub(v, ProjectOnto(v, other))
+}
+
+// replaces this vector with the part of it that is perpendicular to other
+func (v *Vector) RejectFrom(other Vector) *Vector {
+	v.Sub(ProjectOnto(*v, other))
+
+	return v
+}
+
 // normalise the vector
 func Normalise(v Vector) Vector {
 	m := v.Mag()
@@ -256,10 +493,10 @@ func Normalise(v Vector) Vector {
 }
 
 // normalise this vector
-func (v *Vector) Normalise() {
+func (v *Vector) Normalise() *Vector {
 	m := v.Mag()
 
-	v.Div(m)
+	return v.Div(m)
 }
 
 // limits the magnitude of the vector to passed in float64
@@ -276,14 +513,148 @@ func Limit(v Vector, l float64) Vector {
 }
 
 // limit the magnitude of this vector
-func (v *Vector) Limit(l float64) {
+func (v *Vector) Limit(l float64) *Vector {
 	m := v.Mag()
 	if m <= l {
-		return
+		return v
 	}
 
-	v.Normalise()
-	v.Mult(l)
+	return v.Normalise().Mult(l)
+}
+
+// keeps the magnitude of the vector between lo and hi. A zero vector has no direction so stays zero
+func ClampMag(v Vector, lo, hi float64) Vector {
+	m := v.Mag()
+	if m == 0 || (m >= lo && m <= hi) {
+		return v
+	}
+
+	return Mult(v, max(lo, min(m, hi))/m)
+}
+
+// keeps the magnitude of this vector between lo and hi
+func (v *Vector) ClampMag(lo, hi float64) *Vector {
+	m := v.Mag()
+	if m == 0 || (m >= lo && m <= hi) {
+		return v
+	}
+
+	v.Mult(max(lo, min(m, hi)) / m)
+
+	return v
+}
+
+// the smaller of each component of v1 and v2
+func Min(v1, v2 Vector) Vector {
+	return Vector{min(v1.X, v2.X), min(v1.Y, v2.Y), min(v1.Z, v2.Z)}
+}
+
+// sets each component of this vector to the smaller of it and other's
+func (v *Vector) Min(other Vector) *Vector {
+	*v = Min(*v, other)
+	return v
+}
+
+// the larger of each component of v1 and v2
+func Max(v1, v2 Vector) Vector {
+	return Vector{max(v1.X, v2.X), max(v1.Y, v2.Y), max(v1.Z, v2.Z)}
+}
+
+// sets each component of this vector to the larger of it and other's
+func (v *Vector) Max(other Vector) *Vector {
+	*v = Max(*v, other)
+	return v
+}
+
+// keeps each component of v between the matching components of lo and hi,
+// eg to keep a position inside a rectangle
+func Clamp(v, lo, hi Vector) Vector {
+	return Min(Max(v, lo), hi)
+}
+
+// keeps each component of this vector between those of lo and hi
+func (v *Vector) Clamp(lo, hi Vector) *Vector {
+	*v = Clamp(*v, lo, hi)
+	return v
+}
+
+// wraps each component of v into the range from lo (included) to hi (not
+// included), eg for screen wrapping. Components with an empty range become lo's
+func Wrap(v, lo, hi Vector) Vector {
+	return Vector{wrap(v.X, lo.X, hi.X), wrap(v.Y, lo.Y, hi.Y), wrap(v.Z, lo.Z, hi.Z)}
+}
+
+// wraps each component of this vector into the range from lo to hi
+func (v *Vector) Wrap(lo, hi Vector) *Vector {
+	*v = Wrap(*v, lo, hi)
+	return v
+}
+
+func wrap(a, lo, hi float64) float64 {
+	size := hi - lo
+	if size <= 0 {
+		return lo
+	}
+	a = math.Mod(a-lo, size)
+	if a < 0 {
+		a += size
+	}
+	return lo + a
+}
+
+// the absolute value of each component of v
+func Abs(v Vector) Vector {
+	return Vector{math.Abs(v.X), math.Abs(v.Y), math.Abs(v.Z)}
+}
+
+// makes each component of this vector positive
+func (v *Vector) Abs() *Vector {
+	*v = Abs(*v)
+	return v
+}
+
+// v pointing the opposite way
+func Negate(v Vector) Vector {
+	return Vector{-v.X, -v.Y, -v.Z}
+}
+
+// points this vector the opposite way
+func (v *Vector) Negate() *Vector {
+	*v = Negate(*v)
+	return v
+}
+
+// each component of v rounded down
+func Floor(v Vector) Vector {
+	return Vector{math.Floor(v.X), math.Floor(v.Y), math.Floor(v.Z)}
+}
+
+// rounds each component of this vector down
+func (v *Vector) Floor() *Vector {
+	*v = Floor(*v)
+	return v
+}
+
+// each component of v rounded up
+func Ceil(v Vector) Vector {
+	return Vector{math.Ceil(v.X), math.Ceil(v.Y), math.Ceil(v.Z)}
+}
+
+// rounds each component of this vector up
+func (v *Vector) Ceil() *Vector {
+	*v = Ceil(*v)
+	return v
+}
+
+// each component of v rounded to the nearest whole number, halves away from zero
+func Round(v Vector) Vector {
+	return Vector{math.Round(v.X), math.Round(v.Y), math.Round(v.Z)}
+}
+
+// rounds each component of this vector to the nearest whole number
+func (v *Vector) Round() *Vector {
+	*v = Round(*v)
+	return v
 }
 
 // set magnitude of the vector
@@ -293,26 +664,58 @@ func SetMag(v Vector, m float64) Vector {
 }
 
 // set the magnitude of this vector
-func (v *Vector) SetMag(m float64) {
-	v.Normalise()
-	v.Mult(m)
+func (v *Vector) SetMag(m float64) *Vector {
+	return v.Normalise().Mult(m)
 }
 
-// angle 2d vector makes with with positive x axis. Angle increases clockwise
+// angle 2d vector makes with the positive x axis, in (-π, π], the same as p5's heading()
+//
+// It is atan2(y, x), so angles are positive from +x towards +y, which is
+// clockwise on a y-down screen. FromAngle, Rotate and SetHeading turn the
+// other way (towards -y), so use Direction for an angle to pass back to them.
+// The Z component is ignored
 func Heading(v Vector) float64 {
-	base := NewVector(10, 0)
-	angle := AngleBetween(v, base)
+	h := math.Atan2(v.Y, v.X)
+	if h == -math.Pi {
+		return math.Pi
+	}
+	return h
+}
 
-	return angle
+// the angle v makes with the positive x axis in the direction FromAngle and
+// Rotate use, so heading(FromAngle(a)) == a. In (-π, π]
+func heading(v Vector) float64 {
+	h := math.Atan2(-v.Y, v.X)
+	if h == -math.Pi {
+		return math.Pi
+	}
+	return h
 }
 
-// angle this 2d vector makes with the positive x axis
+// angle this 2d vector makes with the positive x axis, in (-π, π]
 func (v Vector) Heading() float64 {
-	base := NewVector(10, 0)
-	return base.AngleBetween(v)
+	return Heading(v)
+}
+
+// returns a copy of the 2d vector turned to point at angle, without changing its magnitude
+func SetHeading(v Vector, angle float64) Vector {
+	m := math.Hypot(v.X, v.Y)
+	h := FromAngle(angle, m)
+	return Vector{h.X, h.Y, v.Z}
 }
 
-// sets the angle of the vector without changing its magnitude
+// turns this 2d vector to point at angle, without changing its magnitude
+func (v *Vector) SetHeading(angle float64) *Vector {
+	m := math.Hypot(v.X, v.Y)
+	h := FromAngle(angle, m)
+	v.X = h.X
+	v.Y = h.Y
+
+	return v
+}
+
+// rotates v by angle without changing its magnitude. Angle.Rotate does the
+// same with an Angle
 func Rotate(v Vector, angle float64) Vector {
 	// x2 = cos()x1 - sin()y1
 	// y2 = sin()x1 + cos()y1
@@ -324,71 +727,225 @@ func Rotate(v Vector, angle float64) Vector {
 }
 
 // rotates the vector by angle
-func (v *Vector) Rotate(angle float64) {
+func (v *Vector) Rotate(angle float64) *Vector {
 	c := math.Cos(-angle)
 	s := math.Sin(-angle)
 
-	v.X = c*v.X - s*v.Y
-	v.Y = s*v.X + c*v.Y
+	x := v.X
+	v.X = c*x - s*v.Y
+	v.Y = s*x + c*v.Y
+
+	return v
 }
 
-// creates a vector of length l in the direction angle
+// rotates v by angle about axis (which doesn't need to be a unit vector) using
+// Rodrigues' formula. It turns the same way as Rotate does about +z
+func RotateAxis(v, axis Vector, angle float64) Vector {
+	m := axis.Mag()
+	if m == 0 {
+		return v
+	}
+	k := Div(axis, m)
+	c := math.Cos(-angle)
+	s := math.Sin(-angle)
+
+	// v cos + (k × v) sin + k (k · v)(1 - cos)
+	r := Mult(v, c)
+	r.Add(Mult(Cross(k, v), s))
+	r.Add(Mult(k, DotProduct(k, v)*(1-c)))
+	return r
+}
+
+// rotates the vector by angle about axis
+func (v *Vector) RotateAxis(axis Vector, angle float64) *Vector {
+	*v = RotateAxis(*v, axis, angle)
+	return v
+}
+
+// rotates v by angle about the x axis, turning +y towards -z
+func RotateX(v Vector, angle float64) Vector {
+	c := math.Cos(-angle)
+	s := math.Sin(-angle)
+	return Vector{v.X, c*v.Y - s*v.Z, s*v.Y + c*v.Z}
+}
+
+// rotates the vector by angle about the x axis
+func (v *Vector) RotateX(angle float64) *Vector {
+	*v = RotateX(*v, angle)
+	return v
+}
+
+// rotates v by angle about the y axis, turning +z towards -x
+func RotateY(v Vector, angle float64) Vector {
+	c := math.Cos(-angle)
+	s := math.Sin(-angle)
+	return Vector{c*v.X + s*v.Z, v.Y, c*v.Z - s*v.X}
+}
+
+// rotates the vector by angle about the y axis
+func (v *Vector) RotateY(angle float64) *Vector {
+	*v = RotateY(*v, angle)
+	return v
+}
+
+// rotates v by angle about the z axis. Unlike Rotate it keeps Z
+func RotateZ(v Vector, angle float64) Vector {
+	c := math.Cos(-angle)
+	s := math.Sin(-angle)
+	return Vector{c*v.X - s*v.Y, s*v.X + c*v.Y, v.Z}
+}
+
+// rotates the vector by angle about the z axis
+func (v *Vector) RotateZ(angle float64) *Vector {
+	*v = RotateZ(*v, angle)
+	return v
+}
+
+// linear interpolation from v1 (t = 0) to v2 (t = 1)
+func Lerp(v1, v2 Vector, t float64) Vector {
+	return Vector{
+		v1.X + (v2.X-v1.X)*t,
+		v1.Y + (v2.Y-v1.Y)*t,
+		v1.Z + (v2.Z-v1.Z)*t,
+	}
+}
+
+// moves this vector t of the way towards other
+func (v *Vector) Lerp(other Vector, t float64) *Vector {
+	v.X += (other.X - v.X) * t
+	v.Y += (other.Y - v.Y) * t
+	v.Z += (other.Z - v.Z) * t
+
+	return v
+}
+
+// spherical interpolation from v1 (t = 0) to v2 (t = 1)
 //
-// FromAngle(Angle float64, length float64). If length omitted then unit vector created
-func FromAngle(values ...float64) Vector {
-	angle := -1.0 * values[0]
-	length := 1.0
-	if len(values) == 2 {
-		length = values[1]
+// The direction turns at a steady rate through the angle between the vectors
+// while the magnitude is interpolated linearly. If either vector has no
+// direction it falls back to Lerp
+func Slerp(v1, v2 Vector, t float64) Vector {
+	m1 := v1.Mag()
+	m2 := v2.Mag()
+	if m1 == 0 || m2 == 0 {
+		return Lerp(v1, v2, t)
 	}
 
-	x := math.Cos(angle)
-	y := math.Sin(angle)
+	u1 := Div(v1, m1)
+	u2 := Div(v2, m2)
+	m := m1 + (m2-m1)*t
 
-	v := NewVector(x, y)
+	cos := max(-1, min(1, u1.DotProduct(u2)))
+	omega := math.Acos(cos)
 
-	v.Normalise()
-	if length != 1 {
-		v.Mult(length)
+	if omega < 1e-9 {
+		return Mult(Normalise(Lerp(u1, u2, t)), m)
 	}
 
-	switch quadrant(angle) {
-	case "ne":
-		v.X = math.Abs(v.X)
-		v.Y = math.Abs(v.Y)
-	case "se":
-		v.X = math.Abs(v.X)
-		v.Y = -1.0 * math.Abs(v.Y)
-	case "sw":
-		v.X = -1.0 * math.Abs(v.X)
-		v.Y = -1.0 * math.Abs(v.Y)
-	case "nw":
-		v.X = -1.0 * math.Abs(v.X)
-		v.Y = math.Abs(v.Y)
+	if math.Pi-omega < 1e-9 {
+		// opposite directions so any perpendicular will do, turn through the xy plane if we can
+		w := Cross(NewVector(0, 0, 1), u1)
+		if w.MagSq() < 1e-18 {
+			w = Cross(NewVector(1, 0, 0), u1)
+		}
+		w.Normalise()
+
+		dir := Add(Mult(u1, math.Cos(omega*t)), Mult(w, math.Sin(omega*t)))
+		return Mult(dir, m)
 	}
 
+	s := math.Sin(omega)
+	dir := Add(Mult(u1, math.Sin((1-t)*omega)/s), Mult(u2, math.Sin(t*omega)/s))
+	return Mult(dir, m)
+}
+
+// turns this vector t of the way towards other
+func (v *Vector) Slerp(other Vector, t float64) *Vector {
+	*v = Slerp(*v, other, t)
+
 	return v
 }
 
-func quadrant(angle float64) string {
-	angle = math.Mod(angle, 2*math.Pi)
+// moves current towards target by at most maxDelta, stopping at target rather than overshooting
+func MoveTowards(current, target Vector, maxDelta float64) Vector {
+	d := Sub(target, current)
+	dist := d.Mag()
+	if dist <= maxDelta || dist == 0 {
+		return target
+	}
+	return Add(current, Mult(d, maxDelta/dist))
+}
 
-	if angle >= 0 && angle <= math.Pi/2 {
-		log.Printf("%.2fπ  %s", angle, "se")
-		return "se"
+// moves this vector towards target by at most maxDelta
+func (v *Vector) MoveTowards(target Vector, maxDelta float64) *Vector {
+	*v = MoveTowards(*v, target, maxDelta)
+	return v
+}
+
+// turns the 2d vector current towards the heading of target by at most
+// maxRadians, without changing its magnitude, eg for a vehicle with a
+// limited turn rate. It turns whichever way is shorter
+func RotateTowards(current, target Vector, maxRadians float64) Vector {
+	if current.X == 0 && current.Y == 0 || target.X == 0 && target.Y == 0 {
+		return current
 	}
 
-	if angle >= math.Pi/2 && angle <= math.Pi {
-		log.Printf("%.2fπ  %s", angle, "sw")
-		return "sw"
+	delta := DeltaAngle(heading(current), heading(target))
+	if math.Abs(delta) <= maxRadians {
+		current.SetHeading(heading(target))
+		return current
 	}
+	current.Rotate(math.Copysign(maxRadians, delta))
+	return current
+}
 
-	if angle >= math.Pi && angle < 3*math.Pi/2 {
-		log.Printf("%.2fπ  %s", angle, "nw")
-		return "nw"
+// turns this vector towards the heading of target by at most maxRadians
+func (v *Vector) RotateTowards(target Vector, maxRadians float64) *Vector {
+	*v = RotateTowards(*v, target, maxRadians)
+	return v
+}
+
+// moves current towards target like a critically damped spring, taking about
+// smoothTime to get there, eg for a camera following a player
+//
+// velocity carries the speed between calls so keep one per thing being moved,
+// starting at zero. Unlike calling Lerp each frame it behaves the same
+// whatever dt is, and it never overshoots target. This is the approximation
+// from Game Programming Gems 4 that Unity's SmoothDamp uses
+func SmoothDamp(current, target Vector, velocity *Vector, smoothTime, dt float64) Vector {
+	smoothTime = max(1e-4, smoothTime)
+	omega := 2 / smoothTime
+	x := omega * dt
+	decay := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	change := Sub(current, target)
+	temp := Mult(Add(*velocity, Mult(change, omega)), dt)
+	*velocity = Mult(Sub(*velocity, Mult(temp, omega)), decay)
+	out := Add(target, Mult(Add(change, temp), decay))
+
+	// stop at the target rather than passing it
+	if DotProduct(Sub(target, current), Sub(out, target)) > 0 {
+		*velocity = Vector{}
+		return target
 	}
-	log.Printf("%.2fπ  %s", angle, "ne")
+	return out
+}
+
+// moves this vector towards target with SmoothDamp
+func (v *Vector) SmoothDamp(target Vector, velocity *Vector, smoothTime, dt float64) *Vector {
+	*v = SmoothDamp(*v, target, velocity, smoothTime, dt)
+	return v
+}
 
-	return "ne"
+// creates a vector of length l in the direction angle
+//
+// FromAngle(Angle float64, length float64). If length omitted then unit vector created.
+// Angle.Vector does the same with an Angle
+func FromAngle(values ...float64) Vector {
+	length := 1.0
+	if len(values) == 2 {
+		length = values[1]
+	}
 
+	return Polar{length, values[0]}.ToVector()
 }
@@ -46,6 +46,64 @@ func TestCreate(t *testing.T) {
 
 }
 
+func TestMultDivVec(t *testing.T) {
+	v := NewVector(2, -3, 4)
+
+	if m := MultVec(v, NewVector(3, 2, 0.5)); !m.Equals(NewVector(6, -6, 2)) {
+		t.Errorf("should be (6, -6, 2) not %v", m)
+	}
+	if d := DivVec(v, NewVector(4, -1)); !d.Equals(NewVector(0.5, 3, 4)) {
+		t.Errorf("should be (0.5, 3, 4) with z left alone, not %v", d)
+	}
+
+	v.MultVec(NewVector(2, 2, 2)).DivVec(NewVector(4, 3, 8))
+	if !v.Equals(NewVector(1, -2, 1)) {
+		t.Errorf("should be (1, -2, 1) not %v", v)
+	}
+}
+
+func TestRem(t *testing.T) {
+	t.Run("component-wise remainder", func(t *testing.T) {
+		v := Rem(NewVector(7, -7, 2.5), NewVector(3, 3, 1))
+
+		if !v.Equals(NewVector(1, -1, 0.5)) {
+			t.Errorf("should be {1, -1, 0.5} not %v", v)
+		}
+	})
+
+	t.Run("zero divisor leaves the component alone", func(t *testing.T) {
+		v := NewVector(12, 5, 9)
+		v.Rem(NewVector(5, 0, 4))
+
+		if !v.Equals(NewVector(2, 5, 1)) {
+			t.Errorf("should be {2, 5, 1} not %v", v)
+		}
+	})
+}
+
+func TestChaining(t *testing.T) {
+	t.Run("chain mutating methods", func(t *testing.T) {
+		v := NewVector(1, 2)
+		r := v.Add(NewVector(5, 6)).Mult(0.5).Limit(4)
+
+		if r != &v {
+			t.Error("chained methods should return the same vector")
+		}
+		if !v.Equals(NewVector(2.4, 3.2)) {
+			t.Errorf("should be {2.4, 3.2} not %v", v)
+		}
+	})
+
+	t.Run("in place rotate matches Rotate", func(t *testing.T) {
+		v := NewVector(3, 1)
+		expected := Rotate(v, 0.7)
+
+		if !v.Rotate(0.7).Equals(expected) {
+			t.Errorf("should be %v not %v", expected, v)
+		}
+	})
+}
+
 func TestDotProduct(t *testing.T) {
 	v1 := NewVector(3, 4)
 	v2 := NewVector(3, 0)
@@ -58,6 +116,32 @@ func TestDotProduct(t *testing.T) {
 
 }
 
+func TestCross(t *testing.T) {
+	t.Run("x cross y is z", func(t *testing.T) {
+		x := NewVector(1, 0, 0)
+		y := NewVector(0, 1, 0)
+
+		z := Cross(x, y)
+		if !z.Equals(NewVector(0, 0, 1)) {
+			t.Errorf("should have been {0, 0, 1} not %v", z)
+		}
+
+		if !y.Cross(x).Equals(NewVector(0, 0, -1)) {
+			t.Errorf("y cross x should be {0, 0, -1} not %v", y.Cross(x))
+		}
+	})
+
+	t.Run("cross product is perpendicular to both", func(t *testing.T) {
+		v1 := NewVector(2, -3, 1)
+		v2 := NewVector(4, 1, -5)
+
+		c := v1.Cross(v2)
+		if !compare(t, c.DotProduct(v1), 0) || !compare(t, c.DotProduct(v2), 0) {
+			t.Errorf("%v is not perpendicular to %v and %v", c, v1, v2)
+		}
+	})
+}
+
 func TestAngleBetween(t *testing.T) {
 
 	t.Run("check perpindicular lines", func(t *testing.T) {
@@ -128,14 +212,170 @@ func TestNormalise(t *testing.T) {
 
 }
 
+func TestReflect(t *testing.T) {
+	t.Run("bounce off the floor", func(t *testing.T) {
+		v := Reflect(NewVector(3, 4), NewVector(0, -10))
+
+		if !v.Equals(NewVector(3, -4)) {
+			t.Errorf("should be {3, -4} not %v", v)
+		}
+	})
+
+	t.Run("bounce off a slope", func(t *testing.T) {
+		v := NewVector(1, 0)
+		v.Reflect(NewVector(-1, 1))
+
+		if !v.Equals(NewVector(0, 1)) {
+			t.Errorf("should be {0, 1} not %v", v)
+		}
+	})
+}
+
+func TestProjectOnto(t *testing.T) {
+	t.Run("project and reject add back up", func(t *testing.T) {
+		v := NewVector(3, 4, 5)
+		onto := NewVector(2, 0, 0)
+
+		p := ProjectOnto(v, onto)
+		r := RejectFrom(v, onto)
+
+		if !p.Equals(NewVector(3, 0, 0)) || !r.Equals(NewVector(0, 4, 5)) {
+			t.Errorf("wrong split %v %v", p, r)
+		}
+		if !Add(p, r).Equals(v) {
+			t.Errorf("%v + %v should be %v", p, r, v)
+		}
+	})
+
+	t.Run("slide along a wall", func(t *testing.T) {
+		v := NewVector(2, 2)
+		v.RejectFrom(NewVector(0, -1))
+
+		if !v.Equals(NewVector(2, 0)) {
+			t.Errorf("should slide along the floor {2, 0} not %v", v)
+		}
+
+		v = NewVector(2, 2)
+		v.ProjectOnto(NewVector())
+		if !v.Equals(Vector{}) {
+			t.Errorf("projecting onto zero should be zero not %v", v)
+		}
+	})
+
+	t.Run("scalar projection", func(t *testing.T) {
+		v := NewVector(3, 4, 5)
+
+		if s := ScalarProject(v, NewVector(0, 10)); s != 4 {
+			t.Errorf("should be 4 along +y not %f", s)
+		}
+		if s := v.ScalarProject(NewVector(-2, 0)); s != -3 {
+			t.Errorf("should be -3 along -x not %f", s)
+		}
+		if s := ScalarProject(v, Vector{}); s != 0 {
+			t.Errorf("should be 0 onto the zero vector not %f", s)
+		}
+		if s, p := ScalarProject(v, NewVector(1, 1)), ProjectOnto(v, NewVector(1, 1)); !compare(t, s, p.Mag()) {
+			t.Errorf("should match the length of the projection %f not %f", p.Mag(), s)
+		}
+	})
+}
+
+func TestMinMaxClamp(t *testing.T) {
+	a := NewVector(1, 5, -2)
+	b := NewVector(3, -1, -2)
+
+	if m := Min(a, b); !m.Equals(NewVector(1, -1, -2)) {
+		t.Errorf("min should be (1, -1, -2) not %v", m)
+	}
+	if m := Max(a, b); !m.Equals(NewVector(3, 5, -2)) {
+		t.Errorf("max should be (3, 5, -2) not %v", m)
+	}
+
+	v := NewVector(-4, 12, 0.5)
+	v.Clamp(NewVector(0, 0), NewVector(10, 10, 1))
+	if !v.Equals(NewVector(0, 10, 0.5)) {
+		t.Errorf("clamp should be (0, 10, 0.5) not %v", v)
+	}
+}
+
+func TestComponentRounding(t *testing.T) {
+	v := NewVector(-1.5, 2.4, 0.5)
+
+	for name, tc := range map[string]struct{ got, expected Vector }{
+		"abs":    {Abs(v), NewVector(1.5, 2.4, 0.5)},
+		"negate": {Negate(v), NewVector(1.5, -2.4, -0.5)},
+		"floor":  {Floor(v), NewVector(-2, 2, 0)},
+		"ceil":   {Ceil(v), NewVector(-1, 3, 1)},
+		"round":  {Round(v), NewVector(-2, 2, 1)},
+	} {
+		if !tc.got.Equals(tc.expected) {
+			t.Errorf("%s should be %v not %v", name, tc.expected, tc.got)
+		}
+	}
+
+	v.Negate().Abs().Floor()
+	if !v.Equals(NewVector(1, 2, 0)) {
+		t.Errorf("chained should be (1, 2, 0) not %v", v)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	lo, hi := NewVector(0, 0), NewVector(800, 600)
+
+	for _, tc := range []struct{ v, expected Vector }{
+		{NewVector(810, 300), NewVector(10, 300)},
+		{NewVector(-5, -1), NewVector(795, 599)},
+		{NewVector(800, 1800), NewVector(0, 0)},
+		{NewVector(400, 300), NewVector(400, 300)},
+	} {
+		if w := Wrap(tc.v, lo, hi); !w.Equals(tc.expected) {
+			t.Errorf("%v should wrap to %v not %v", tc.v, tc.expected, w)
+		}
+	}
+
+	v := NewVector(-2.5, 7, 3)
+	v.Wrap(NewVector(-1, -1), NewVector(1, 1, 0))
+	if !v.Equals(NewVector(-0.5, -1, 0)) {
+		t.Errorf("should be (-0.5, -1, 0) not %v", v)
+	}
+}
+
+func TestClampMag(t *testing.T) {
+	t.Run("too fast", func(t *testing.T) {
+		v := ClampMag(NewVector(30, 40), 1, 10)
+
+		if !v.Equals(NewVector(6, 8)) {
+			t.Errorf("should be {6, 8} not %v", v)
+		}
+	})
+
+	t.Run("too slow", func(t *testing.T) {
+		v := NewVector(0.3, 0.4)
+		v.ClampMag(1, 10)
+
+		if !v.Equals(NewVector(0.6, 0.8)) {
+			t.Errorf("should be {0.6, 0.8} not %v", v)
+		}
+	})
+
+	t.Run("in range and zero are left alone", func(t *testing.T) {
+		if v := ClampMag(NewVector(3, 4), 1, 10); !v.Equals(NewVector(3, 4)) {
+			t.Errorf("should be unchanged not %v", v)
+		}
+		if v := ClampMag(NewVector(), 1, 10); !v.Equals(NewVector()) {
+			t.Errorf("zero should stay zero not %v", v)
+		}
+	})
+}
+
 func TestHeading(t *testing.T) {
 	t.Run("Test angle in SE quadrant", func(t *testing.T) {
 		v := NewVector(5, -5, 6)
 
 		h := Heading(v)
 
-		if compare(t, h, math.Pi/4) {
-			t.Errorf("should be + pi/4(%f) not %f", math.Pi/4, h)
+		if !compare(t, h, -math.Pi/4) {
+			t.Errorf("should be - pi/4(%f) not %f", -math.Pi/4, h)
 		}
 	})
 
@@ -144,11 +384,62 @@ func TestHeading(t *testing.T) {
 
 		h := Heading(v)
 
-		if compare(t, h, (2*math.Pi)-math.Pi/4) {
+		if !compare(t, h, math.Pi/4) {
 			t.Errorf("should be + pi/4(%f) not %f", math.Pi/4, h)
 		}
 	})
 
+	t.Run("Test matches p5", func(t *testing.T) {
+		// createVector(0, 1).heading() is HALF_PI
+		if h := Heading(NewVector(0, 1)); h != math.Pi/2 {
+			t.Errorf("should be pi/2(%f) not %f", math.Pi/2, h)
+		}
+	})
+
+	t.Run("Test angle along the negative x axis", func(t *testing.T) {
+		v := NewVector(-3, 0)
+
+		if h := v.Heading(); h != math.Pi {
+			t.Errorf("should be pi(%f) not %f", math.Pi, h)
+		}
+	})
+
+	t.Run("Test direction undoes FromAngle", func(t *testing.T) {
+		for _, a := range []float64{0.3, 2, -1, -3} {
+			v := FromAngle(a, 4)
+			if d := Direction(v).Radians(); !compare(t, d, a) {
+				t.Errorf("direction of FromAngle(%f) was %f", a, d)
+			}
+			if h := Heading(v); !compare(t, h, -a) {
+				t.Errorf("heading of FromAngle(%f) should be %f not %f", a, -a, h)
+			}
+		}
+	})
+
+}
+
+func TestSetHeading(t *testing.T) {
+	t.Run("keeps the magnitude", func(t *testing.T) {
+		v := NewVector(3, 4)
+		v.SetHeading(math.Pi / 2)
+
+		if !v.Equals(NewVector(0, -5)) {
+			t.Errorf("should be {0, -5} not %v", v)
+		}
+	})
+
+	t.Run("matches FromAngle", func(t *testing.T) {
+		v := NewVector(-1, 0, 7)
+		angle := 3 * math.Pi / 4
+
+		h := SetHeading(v, angle)
+		expected := FromAngle(angle)
+		expected.Z = 7
+
+		if !h.Equals(expected) {
+			t.Errorf("should be %v not %v", expected, h)
+		}
+	})
 }
 
 func TestRotate(t *testing.T) {
@@ -189,6 +480,177 @@ func TestRotate(t *testing.T) {
 	})
 }
 
+func TestRotateAxis(t *testing.T) {
+	t.Run("about z matches Rotate", func(t *testing.T) {
+		v := NewVector(3, 1)
+
+		if r := RotateAxis(v, NewVector(0, 0, 2), 0.6); !r.Equals(Rotate(v, 0.6)) {
+			t.Errorf("should be %v not %v", Rotate(v, 0.6), r)
+		}
+	})
+
+	t.Run("matches the rotation matrix", func(t *testing.T) {
+		v := NewVector(1, 2, 3)
+		axis := NewVector(-1, 0.5, 2)
+
+		if r := RotateAxis(v, axis, 2.1); !r.Equals(RotateAxis4(axis, 2.1).Transform(v)) {
+			t.Errorf("should be %v not %v", RotateAxis4(axis, 2.1).Transform(v), r)
+		}
+	})
+
+	t.Run("in place", func(t *testing.T) {
+		v := NewVector(0, 1, 0)
+		v.RotateAxis(NewVector(1, 0, 0), math.Pi/2)
+
+		// a quarter turn about +x takes +y to -z
+		if !v.Equals(NewVector(0, 0, -1)) {
+			t.Errorf("should be (0, 0, -1) not %v", v)
+		}
+		if r := RotateAxis(v, Vector{}, 1); !r.Equals(v) {
+			t.Errorf("a zero axis should leave it alone, got %v", r)
+		}
+	})
+}
+
+func TestRotateXYZ(t *testing.T) {
+	v := NewVector(1, -2, 3)
+
+	if r := RotateX(v, 0.8); !r.Equals(RotateX4(0.8).Transform(v)) {
+		t.Errorf("x should be %v not %v", RotateX4(0.8).Transform(v), r)
+	}
+	if r := RotateY(v, 0.8); !r.Equals(RotateY4(0.8).Transform(v)) {
+		t.Errorf("y should be %v not %v", RotateY4(0.8).Transform(v), r)
+	}
+	if r := RotateZ(v, 0.8); !r.Equals(RotateZ4(0.8).Transform(v)) {
+		t.Errorf("z should be %v not %v", RotateZ4(0.8).Transform(v), r)
+	}
+
+	w := NewVector(0, 0, 1)
+	w.RotateY(math.Pi / 2).RotateX(math.Pi / 2)
+	if !w.Equals(NewVector(-1, 0, 0)) {
+		t.Errorf("+z turned about y then x should be -x not %v", w)
+	}
+}
+
+func TestLerp(t *testing.T) {
+	t.Run("lerp half way", func(t *testing.T) {
+		v1 := NewVector(0, 10, -2)
+		v2 := NewVector(10, 0, 2)
+
+		l := Lerp(v1, v2, 0.5)
+		if !l.Equals(NewVector(5, 5, 0)) {
+			t.Errorf("should be {5, 5, 0} not %v", l)
+		}
+
+		v1.Lerp(v2, 0.25)
+		if !v1.Equals(NewVector(2.5, 7.5, -1)) {
+			t.Errorf("should be {2.5, 7.5, -1} not %v", v1)
+		}
+	})
+
+	t.Run("slerp keeps turning at a steady rate", func(t *testing.T) {
+		v1 := NewVector(2, 0)
+		v2 := NewVector(0, 4)
+
+		s := Slerp(v1, v2, 0.5)
+		if !compare(t, s.Mag(), 3) {
+			t.Errorf("magnitude should be 3 not %f", s.Mag())
+		}
+		if !compare(t, s.AngleBetween(v1), math.Pi/4) {
+			t.Errorf("should be half way round not %f", s.AngleBetween(v1))
+		}
+	})
+
+	t.Run("slerp between opposite vectors", func(t *testing.T) {
+		v := NewVector(1, 0)
+		v.Slerp(NewVector(-1, 0), 0.5)
+
+		if !compare(t, v.Mag(), 1) || !compare(t, v.X, 0) {
+			t.Errorf("should be perpendicular unit vector not %v", v)
+		}
+	})
+}
+
+func TestMoveTowards(t *testing.T) {
+	target := NewVector(10, 0)
+
+	if v := MoveTowards(NewVector(0, 0), target, 3); !v.Equals(NewVector(3, 0)) {
+		t.Errorf("should step to (3, 0) not %v", v)
+	}
+	if v := MoveTowards(NewVector(8, 0), target, 3); !v.Equals(target) {
+		t.Errorf("shouldn't overshoot, got %v", v)
+	}
+
+	v := NewVector(10, 8)
+	for range 3 {
+		v.MoveTowards(target, 2)
+	}
+	if !v.Equals(NewVector(10, 2)) {
+		t.Errorf("should be (10, 2) after 3 steps not %v", v)
+	}
+}
+
+func TestRotateTowards(t *testing.T) {
+	t.Run("limited step", func(t *testing.T) {
+		v := RotateTowards(NewVector(4, 0), NewVector(0, -1), 0.5)
+
+		if !v.Equals(FromAngle(0.5, 4)) {
+			t.Errorf("should turn 0.5 towards -y, got %v", v)
+		}
+	})
+
+	t.Run("short way round", func(t *testing.T) {
+		v := RotateTowards(FromAngle(3, 2), FromAngle(-3, 1), 0.1)
+
+		if !v.Equals(FromAngle(3.1, 2)) {
+			t.Errorf("should turn through π, got %v (heading %f)", v, v.Heading())
+		}
+	})
+
+	t.Run("arrives without overshooting", func(t *testing.T) {
+		v := NewVector(3, 0, 7)
+		for range 10 {
+			v.RotateTowards(NewVector(-1, 1), 0.4)
+		}
+
+		if !v.Equals(NewVector(-3/math.Sqrt2, 3/math.Sqrt2, 7)) {
+			t.Errorf("should point at (-1, 1) with magnitude 3 and z kept, got %v", v)
+		}
+	})
+}
+
+func TestSmoothDamp(t *testing.T) {
+	run := func(dt float64) Vector {
+		pos, vel := NewVector(0, 0), Vector{}
+		for range int(math.Round(0.5 / dt)) {
+			pos.SmoothDamp(NewVector(10, 5), &vel, 0.3, dt)
+		}
+		return pos
+	}
+
+	t.Run("independent of frame rate", func(t *testing.T) {
+		a, b := run(1.0/30), run(1.0/144)
+
+		if Dist(a, b) > 0.1 {
+			t.Errorf("30fps and 144fps should end up close, got %v and %v", a, b)
+		}
+	})
+
+	t.Run("settles without overshooting", func(t *testing.T) {
+		pos, vel := NewVector(0, 0), Vector{}
+		for range 300 {
+			pos.SmoothDamp(NewVector(10, 0), &vel, 0.3, 1.0/60)
+			if pos.X > 10 {
+				t.Fatalf("overshot to %v", pos)
+			}
+		}
+
+		if Dist(pos, NewVector(10, 0)) > 1e-3 || vel.Mag() > 1e-2 {
+			t.Errorf("should have settled at (10, 0), got %v moving at %v", pos, vel)
+		}
+	})
+}
+
 func TestFromAngle(t *testing.T) {
 	test := func(t *testing.T, v1, v2 Vector, angle float64) {
 		t.Helper()
@@ -215,10 +677,10 @@ func TestFromAngle(t *testing.T) {
 	t.Run(
 		"Test from angle with only angle given",
 		func(t *testing.T) {
-			angle := (5.0 * math.Pi / 4.0) - 0.001
+			angle := 5.0 * math.Pi / 4.0
 			v := FromAngle(angle)
 
-			expected := NewVector(-1, -1)
+			expected := NewVector(-1, 1)
 			expected.Normalise()
 
 			test(t, v, expected, angle)
@@ -233,3 +695,104 @@ func compare(t *testing.T, a, b float64) bool {
 	log.Println(c)
 	return c < 1.0e-8
 }
+
+func TestSignedAngle2D(t *testing.T) {
+	t.Run("turns both ways", func(t *testing.T) {
+		right := NewVector(2, 0)
+
+		if a := SignedAngle2D(right, NewVector(0, -3)); !compare(t, a, math.Pi/2) {
+			t.Errorf("turning towards -y should be pi/2 not %f", a)
+		}
+		if a := right.SignedAngle2D(NewVector(0, 3)); !compare(t, a, -math.Pi/2) {
+			t.Errorf("turning towards +y should be -pi/2 not %f", a)
+		}
+		// heading right along a y-down screen, up the screen is a left turn
+		// and down it a right turn
+		up, down := NewVector(1, -1), NewVector(1, 1)
+		if a := SignedAngle2D(right, up); a <= 0 {
+			t.Errorf("a left turn should be positive not %f", a)
+		}
+		if a := SignedAngle2D(right, down); a >= 0 {
+			t.Errorf("a right turn should be negative not %f", a)
+		}
+		if a := SignedAngle2D(right, NewVector(-1, 0)); a != math.Pi {
+			t.Errorf("turning round should be pi not %f", a)
+		}
+	})
+
+	t.Run("matches Rotate", func(t *testing.T) {
+		v1 := NewVector(3, 1, 5)
+		v2 := NewVector(-2, -4)
+
+		r := Normalise(Rotate(NewVector(v1.X, v1.Y), SignedAngle2D(v1, v2)))
+		if !r.Equals(Normalise(v2)) {
+			t.Errorf("rotating v1 should point along %v not %v", Normalise(v2), r)
+		}
+	})
+}
+
+func TestTripleProduct(t *testing.T) {
+	t.Run("scalar", func(t *testing.T) {
+		x, y, z := NewVector(1, 0, 0), NewVector(0, 1, 0), NewVector(0, 0, 1)
+
+		if v := TripleProductScalar(Mult(x, 2), Mult(y, 3), Mult(z, 4)); v != 24 {
+			t.Errorf("box volume should be 24 not %f", v)
+		}
+		if v := TripleProductScalar(y, x, z); v != -1 {
+			t.Errorf("left handed should be -1 not %f", v)
+		}
+		if v := TripleProductScalar(x, y, NewVector(3, -2, 0)); v != 0 {
+			t.Errorf("coplanar should be 0 not %f", v)
+		}
+	})
+
+	t.Run("vector", func(t *testing.T) {
+		a, b, c := NewVector(1, 2, 3), NewVector(-1, 0, 2), NewVector(4, 1, -2)
+
+		want := Sub(Mult(b, DotProduct(a, c)), Mult(c, DotProduct(a, b)))
+		if v := TripleProductVector(a, b, c); !v.Equals(want) {
+			t.Errorf("should be %v not %v", want, v)
+		}
+	})
+}
+
+func TestPredicates(t *testing.T) {
+	t.Run("zero and unit", func(t *testing.T) {
+		if !IsZero(Vector{}) || !NewVector(1e-10, 0, -1e-10).IsZero() {
+			t.Error("should be zero")
+		}
+		if IsZero(NewVector(1e-3)) || !IsZero(NewVector(1e-3), 1e-2) {
+			t.Error("should use the tolerance passed in")
+		}
+		if !IsUnit(Normalise(NewVector(3, -4, 12))) || IsUnit(NewVector(1, 1)) {
+			t.Error("only the normalised vector should be a unit vector")
+		}
+		if !NewVector(1.01).IsUnit(0.05) {
+			t.Error("should be a unit vector within 0.05")
+		}
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		if !IsParallel(NewVector(1, 2), NewVector(-3, -6)) {
+			t.Error("opposite 2d vectors should be parallel")
+		}
+		if !NewVector(1, 2, 3).IsParallel(NewVector(100, 200, 300)) {
+			t.Error("scaled 3d vectors should be parallel")
+		}
+		if IsParallel(NewVector(1, 0), NewVector(1, 0.01)) || !IsParallel(NewVector(1, 0), NewVector(1, 0.01), 0.02) {
+			t.Error("a small angle should only be parallel with a loose tolerance")
+		}
+	})
+
+	t.Run("perpendicular", func(t *testing.T) {
+		if !IsPerpendicular(NewVector(2, 1), NewVector(-1, 2)) {
+			t.Error("2d vectors at right angles should be perpendicular")
+		}
+		if !NewVector(1, 1, 0).IsPerpendicular(NewVector(0, 0, 5)) || IsPerpendicular(NewVector(1, 1), NewVector(1, 0)) {
+			t.Error("only the right angle should be perpendicular")
+		}
+		if !IsPerpendicular(NewVector(1e6, 0), NewVector(1e-3, 1e6)) {
+			t.Error("tolerance shouldn't depend on the lengths")
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package vector
+
+// a point moved by Verlet integration, which keeps its velocity implicitly as
+// the difference between where it is and where it was
+type VerletPoint struct {
+	Pos, Prev Vector
+	Pinned    bool
+}
+
+// a point at rest at p
+func NewVerletPoint(p Vector) VerletPoint {
+	return VerletPoint{Pos: p, Prev: p}
+}
+
+// the distance moved in the last step
+func (p *VerletPoint) Velocity() Vector {
+	return Sub(p.Pos, p.Prev)
+}
+
+// moves the point on by dt under acceleration acc, keeping damping (0 to 1) of
+// its velocity. Pinned points don't move
+func (p *VerletPoint) Step(acc Vector, dt, damping float64) {
+	if p.Pinned {
+		p.Prev = p.Pos
+		return
+	}
+	next := Add(Add(p.Pos, Mult(p.Velocity(), damping)), Mult(acc, dt*dt))
+	p.Prev = p.Pos
+	p.Pos = next
+}
+
+// moves the point to p without giving it any velocity
+func (p *VerletPoint) Place(pos Vector) {
+	p.Pos = pos
+	p.Prev = pos
+}
+
+// a distance constraint holding points A and B (indices) Length apart
+type Stick struct {
+	A, B   int
+	Length float64
+}
+
+// a stick between points a and b at their current distance
+func NewStick(points []VerletPoint, a, b int) Stick {
+	return Stick{a, b, Dist(points[a].Pos, points[b].Pos)}
+}
+
+// pushes points a and b towards the stick's length, moving each by half the
+// error, or all of it when the other one is pinned
+func (s Stick) Satisfy(points []VerletPoint) {
+	a, b := &points[s.A], &points[s.B]
+	if a.Pinned && b.Pinned {
+		return
+	}
+
+	delta := Sub(b.Pos, a.Pos)
+	d := Mag(delta)
+	if d == 0 {
+		return
+	}
+	correction := Mult(delta, (d-s.Length)/d)
+
+	switch {
+	case a.Pinned:
+		b.Pos.Sub(correction)
+	case b.Pinned:
+		a.Pos.Add(correction)
+	default:
+		half := Mult(correction, 0.5)
+		a.Pos.Add(half)
+		b.Pos.Sub(half)
+	}
+}
+
+// satisfies every stick in turn, iterations times. More iterations make the
+// sticks stiffer
+func SatisfySticks(points []VerletPoint, sticks []Stick, iterations int) {
+	for range iterations {
+		for _, s := range sticks {
+			s.Satisfy(points)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package vector
+
+import "math"
+
+// a wind force at time t: base, made stronger and weaker by gusts
+//
+// gustAmplitude is the fraction the strength swings by (0.5 means between half
+// and one and a half times base) and gustFrequency roughly how many gusts
+// there are per unit of t. The gusts come from Noise so they don't repeat
+func Wind(base Vector, gustAmplitude, gustFrequency float64, t float64) Vector {
+	gust := Noise(t*gustFrequency, 0, 0)*2 - 1
+	return Mult(base, 1+gustAmplitude*gust)
+}
+
+// a smoothly varying force at p and time t for stirring up particles, with
+// each component between -1 and 1
+//
+// The field changes over about one unit of p, so scale p first to set the
+// size of the swirls and multiply the result to set the strength
+func Turbulence(p Vector, t float64) Vector {
+	// sample the x and y components from well separated parts of the noise so they're unrelated
+	x := Noise(p.X, p.Y, t)*2 - 1
+	y := Noise(p.X+turbulenceOffset, p.Y+turbulenceOffset, t)*2 - 1
+	return NewVector(x, y)
+}
+
+var turbulenceOffset = 1000 * math.Phi
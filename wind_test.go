@@ -0,0 +1,34 @@
+package vector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWind(t *testing.T) {
+	base := NewVector(4, 0)
+	for i := range 100 {
+		w := Wind(base, 0.5, 2, float64(i)*0.1)
+		if w.Y != 0 || w.X < 2 || w.X > 6 {
+			t.Errorf("wind should stay along base within half its strength, got %v", w)
+		}
+	}
+	if w := Wind(base, 0, 2, 3.3); !w.Equals(base) {
+		t.Errorf("no gusts should give base not %v", w)
+	}
+}
+
+func TestTurbulence(t *testing.T) {
+	p := NewVector(0.3, 0.7)
+	f := Turbulence(p, 0.5)
+
+	if math.Abs(f.X) > 1 || math.Abs(f.Y) > 1 {
+		t.Errorf("components should be within ±1, got %v", f)
+	}
+	if f.X == f.Y {
+		t.Errorf("components should differ, got %v", f)
+	}
+	if d := Dist(f, Turbulence(p, 0.501)); d > 0.01 {
+		t.Errorf("should change smoothly over time, jumped by %f", d)
+	}
+}